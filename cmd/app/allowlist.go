@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// parseCIDRs parses ip addresses and CIDR ranges. A plain ip address is treated as a single host range.
+func parseCIDRs(vals []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(vals))
+
+	for _, val := range vals {
+		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
+
+		if !strings.Contains(val, "/") {
+			ip := net.ParseIP(val)
+			if ip == nil {
+				return nil, fmt.Errorf("incorrect ip address %q", val)
+			}
+			bits := 32
+			if ip.To4() == nil {
+				bits = 128
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipNet, err := net.ParseCIDR(val)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect CIDR %q: %v", val, err)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	return nets, nil
+}
+
+// remoteIP returns ip address of the client. When the service is behind trustedHops proxies, each proxy
+// appends the address it received the request from to X-Forwarded-For, so the client is the entry trustedHops
+// from the right. Entries further left are set by the client and cannot be trusted.
+func remoteIP(r *http.Request, trustedHops int) net.IP {
+	if trustedHops > 0 {
+		var addrs []string
+		for _, fwd := range r.Header.Values("X-Forwarded-For") {
+			addrs = append(addrs, strings.Split(fwd, ",")...)
+		}
+		if len(addrs) < trustedHops {
+			return nil
+		}
+		return net.ParseIP(strings.TrimSpace(addrs[len(addrs)-trustedHops]))
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	return net.ParseIP(host)
+}
+
+// ipAllowed checks whether the ip is in one of the ranges
+func ipAllowed(ip net.IP, nets []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
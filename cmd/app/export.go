@@ -0,0 +1,157 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	stk_app_v1 "github.com/gidyon/mpesastk/internal/stk/v1"
+	stk_v1 "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newExportStore creates the blob store for files of export jobs
+func newExportStore() (stk_app_v1.BlobStore, error) {
+	switch store := viper.GetString("STK_EXPORT_STORE"); store {
+	case "", "local":
+		return stk_app_v1.NewLocalBlobStore(firstVal(viper.GetString("STK_EXPORT_DIR"), "exports"))
+	case "s3":
+		client, err := minio.New(viper.GetString("STK_EXPORT_S3_ENDPOINT"), &minio.Options{
+			Creds: credentials.NewStaticV4(
+				viper.GetString("STK_EXPORT_S3_ACCESS_KEY"), viper.GetString("STK_EXPORT_S3_SECRET_KEY"), "",
+			),
+			Secure: viper.GetBool("STK_EXPORT_S3_USE_SSL"),
+			Region: viper.GetString("STK_EXPORT_S3_REGION"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create s3 client: %v", err)
+		}
+		return stk_app_v1.NewS3BlobStore(client, viper.GetString("STK_EXPORT_S3_BUCKET"), viper.GetString("STK_EXPORT_S3_PREFIX"))
+	default:
+		return nil, fmt.Errorf("unknown export store %q", store)
+	}
+}
+
+// ServeExport downloads stk transactions as a csv, ndjson or xlsx file.
+//
+// Query parameters are similar to ExportStkTransactions e.g ?format=EXPORT_XLSX&columns=phone_number&columns=amount&time_zone=Africa/Nairobi&filter.short_codes=174379
+func (gw *stkGateway) ServeExport(w http.ResponseWriter, r *http.Request) {
+	code, err := gw.serveExport(w, r)
+	if err != nil {
+		gw.Logger.Errorf("Error serving stk export: %v", err)
+		if code != 0 {
+			http.Error(w, err.Error(), code)
+		}
+	}
+}
+
+func (gw *stkGateway) serveExport(w http.ResponseWriter, r *http.Request) (int, error) {
+	if r.Method != http.MethodGet {
+		return http.StatusBadRequest, fmt.Errorf("bad method; only GET allowed; received %v method", r.Method)
+	}
+
+	req := &stk_v1.ExportStkTransactionsRequest{}
+
+	err := runtime.PopulateQueryParameters(req, r.URL.Query(), &utilities.DoubleArray{})
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("failed to parse query parameters: %v", err)
+	}
+
+	// Caller credentials are passed to the stream
+	ctx := metadata.AppendToOutgoingContext(r.Context(), auth.Header(), r.Header.Get("Authorization"))
+
+	stream, err := gw.StkV1Client.ExportStkTransactions(ctx, req)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to export stk transactions: %v", err)
+	}
+
+	// The first chunk carries file details; errors before any data is sent are reported with their status
+	chunk, err := stream.Recv()
+	if err != nil {
+		return runtime.HTTPStatusFromCode(status.Code(err)), fmt.Errorf("failed to export stk transactions: %v", err)
+	}
+
+	w.Header().Set("Content-Type", chunk.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", chunk.FileName))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+
+	for {
+		_, err = w.Write(chunk.Data)
+		if err != nil {
+			return 0, nil
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		chunk, err = stream.Recv()
+		switch {
+		case err == nil:
+		case errors.Is(err, io.EOF):
+			return 0, nil
+		default:
+			// Headers are already sent; the client sees a truncated file
+			return 0, fmt.Errorf("stk export stream closed: %v", err)
+		}
+	}
+}
+
+// ServeExportDownload downloads the gzip compressed file of an export job using the signed link returned by
+// GetExportJob.
+func (gw *stkGateway) ServeExportDownload(w http.ResponseWriter, r *http.Request) {
+	code, err := gw.serveExportDownload(w, r)
+	if err != nil {
+		gw.Logger.Errorf("Error serving export download: %v", err)
+		if code != 0 {
+			http.Error(w, err.Error(), code)
+		}
+	}
+}
+
+func (gw *stkGateway) serveExportDownload(w http.ResponseWriter, r *http.Request) (int, error) {
+	if r.Method != http.MethodGet {
+		return http.StatusBadRequest, fmt.Errorf("bad method; only GET allowed; received %v method", r.Method)
+	}
+
+	query := r.URL.Query()
+
+	jobID, err := strconv.ParseUint(query.Get("job_id"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("incorrect job id")
+	}
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("incorrect expiry")
+	}
+
+	file, err := gw.StkV1API.OpenExportFile(r.Context(), jobID, expires, query.Get("signature"))
+	if err != nil {
+		return runtime.HTTPStatusFromCode(status.Code(err)), err
+	}
+	defer file.Body.Close()
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	w.Header().Set("Content-Length", fmt.Sprint(file.Size))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = io.Copy(w, file.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send export file: %v", err)
+	}
+
+	return 0, nil
+}
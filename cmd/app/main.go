@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"flag"
+	"io"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/gidyon/gomicro"
@@ -17,9 +21,11 @@ import (
 	stk_app_v1 "github.com/gidyon/mpesastk/internal/stk/v1"
 	stk_v1 "github.com/gidyon/mpesastk/pkg/api/stk/v1"
 	"github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/hkdf"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -30,6 +36,12 @@ import (
 var configFile = flag.String("config-file", ".env", "Configuration file")
 
 func main() {
+	// Subcommands
+	if len(os.Args) > 1 && os.Args[1] == replayCallbacksCmd {
+		errs.Panic(runReplayCallbacks(os.Args[2:]))
+		return
+	}
+
 	flag.Parse()
 
 	ctx := context.Background()
@@ -195,6 +207,21 @@ func main() {
 			}
 		)
 
+		// Credentials for other shortcodes
+		var credentials []*stk_app_v1.ShortCodeCredential
+		if viper.GetString("STK_CREDENTIALS_FILE") != "" {
+			credentials, err = stk_app_v1.LoadCredentialsFromFile(viper.GetString("STK_CREDENTIALS_FILE"))
+			errs.Panic(err)
+		}
+
+		// Publisher for stk results
+		publisher, err := newPublisher(redisDB)
+		errs.Panic(err)
+
+		// Store for files of export jobs
+		exportStore, err := newExportStore()
+		errs.Panic(err)
+
 		// STK V1
 		stkV1, err := stk_app_v1.NewStkAPI(ctx, &stk_app_v1.Options{
 			SQLDB:   sqlDB,
@@ -207,7 +234,6 @@ func main() {
 				ConsumerSecret:    firstVal(viper.GetString("STK_CONSUMER_SECRET"), viper.GetString("SAF_CONSUMER_SECRET")),
 				BusinessShortCode: viper.GetString("STK_BUSINESS_SHORT_CODE"),
 				AccountReference:  viper.GetString("STK_MPESA_ACCOUNT_REFERENCE"),
-				Timestamp:         viper.GetString("STK_MPESA_ACCESS_TIMESTAMP"),
 				PassKey:           viper.GetString("STK_LNM_PASSKEY"),
 				CallBackURL:       stkCallbackV1,
 				PostURL:           viper.GetString("STK_MPESA_POST_URL"),
@@ -218,19 +244,59 @@ func main() {
 			AllowQueryStatus:          viper.GetBool("STK_STATUS_QUERY_STATUS_ENABLED"),
 			SystemIdPrefix:            viper.GetString("STK_SYSTEM_ID_PREFIX"),
 			PublishProcessChannel:     viper.GetString("STK_PROCESS_CHANNEL"),
+			Credentials:               credentials,
+			LoadCredentialsFromDB:     viper.GetBool("STK_CREDENTIALS_FROM_DB"),
+			IdempotencyWindow:         viper.GetDuration("STK_IDEMPOTENCY_WINDOW"),
+			CallbackTokenEnabled:      viper.GetBool("STK_CALLBACK_TOKEN_ENABLED"),
+			CrossCheckCallbacks:       viper.GetBool("STK_CALLBACK_CROSS_CHECK"),
+			WebhookSecret:             viper.GetString("STK_WEBHOOK_SECRET"),
+			WebhookMaxAttempts:        viper.GetInt("STK_WEBHOOK_MAX_ATTEMPTS"),
+			WebhookAllowedHosts:       viper.GetStringSlice("STK_WEBHOOK_ALLOWED_HOSTS"),
+			OutboxStuckAfter:          viper.GetDuration("STK_OUTBOX_STUCK_AFTER"),
+			PublishMode:               viper.GetString("STK_PUBLISH_MODE"),
+			StreamMaxLen:              viper.GetInt64("STK_STREAM_MAX_LEN"),
+			Publisher:                 publisher,
+			EventFormat:               viper.GetString("STK_EVENT_FORMAT"),
+			EventSource:               viper.GetString("STK_EVENT_SOURCE"),
+			OutboxMaxAttempts:         viper.GetInt("STK_OUTBOX_MAX_ATTEMPTS"),
+			ProcessMaxDeliveries:      viper.GetInt("STK_PROCESS_MAX_DELIVERIES"),
+			ProcessReplyChannel:       viper.GetString("STK_PROCESS_REPLY_CHANNEL"),
+			PageTokenSecret:           firstVal(viper.GetString("STK_PAGE_TOKEN_SECRET"), deriveKey(jwtKey, "stk page tokens")),
+			ExportStore:               exportStore,
+			ExportDownloadURL:         viper.GetString("STK_EXPORT_DOWNLOAD_URL"),
+			ExportURLExpiry:           viper.GetDuration("STK_EXPORT_URL_TTL"),
+			ExportSigningSecret:       firstVal(viper.GetString("STK_EXPORT_SIGNING_SECRET"), deriveKey(jwtKey, "stk export download links")),
+			ExportJobsChannel:         viper.GetString("STK_EXPORT_JOBS_CHANNEL"),
+			ExportRetention:           viper.GetDuration("STK_EXPORT_RETENTION"),
 		})
 		errs.Panic(err)
 
 		stk_v1.RegisterStkPushV1Server(app.GRPCServer(), stkV1)
 		errs.Panic(stk_v1.RegisterStkPushV1Handler(ctx, app.RuntimeMux(), app.ClientConn()))
 
+		// Safaricom addresses allowed to send callbacks
+		callbackAllowedNets, err := parseCIDRs(viper.GetStringSlice("STK_CALLBACK_ALLOWED_IPS"))
+		errs.Panic(err)
+
+		// X-Forwarded-For is ignored unless the service is behind trusted proxies
+		var trustedProxyHops int
+		if viper.GetBool("STK_CALLBACK_TRUST_FORWARDED_FOR") {
+			trustedProxyHops = viper.GetInt("STK_CALLBACK_TRUSTED_PROXY_HOPS")
+			if trustedProxyHops <= 0 {
+				trustedProxyHops = 1
+			}
+		}
+
 		// Options for gateways
 		opts := &Options{
-			SQLDB:    sqlDB,
-			RedisDB:  redisDB,
-			Logger:   appLogger,
-			AuthAPI:  authAPI,
-			StkV1API: stkV1,
+			SQLDB:               sqlDB,
+			RedisDB:             redisDB,
+			Logger:              appLogger,
+			AuthAPI:             authAPI,
+			StkV1API:            stkV1,
+			StkV1Client:         stk_v1.NewStkPushV1Client(app.ClientConn()),
+			CallbackAllowedNets: callbackAllowedNets,
+			TrustedProxyHops:    trustedProxyHops,
 		}
 
 		// MPESA STK Push gateway
@@ -239,8 +305,18 @@ func main() {
 
 		// V1 endpoint
 		app.AddEndpointFunc("/stk/incoming", stkGateway.ServeStkV1)
+
+		// Server-sent events for stk transactions
+		app.AddEndpointFunc("/stk/events", stkGateway.ServeWatchSSE)
+
+		// File downloads of stk transactions
+		app.AddEndpointFunc("/stk/export", stkGateway.ServeExport)
+		app.AddEndpointFunc("/stk/exports/download", stkGateway.ServeExportDownload)
 		appLogger.Infof("STK callback path: %v", stkCallbackV1)
 
+		// Prometheus metrics
+		app.AddEndpoint("/metrics", promhttp.Handler())
+
 		return nil
 	})
 }
@@ -253,3 +329,11 @@ func firstVal(vals ...string) string {
 	}
 	return ""
 }
+
+// deriveKey derives a key for the purpose from the secret so that keys of different purposes are independent
+func deriveKey(secret, purpose string) string {
+	key := make([]byte, 32)
+	_, err := io.ReadFull(hkdf.New(sha256.New, []byte(secret), nil, []byte(purpose)), key)
+	errs.Panic(err)
+	return hex.EncodeToString(key)
+}
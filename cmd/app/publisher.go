@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	stk_app_v1 "github.com/gidyon/mpesastk/internal/stk/v1"
+	"github.com/go-redis/redis/v8"
+	"github.com/nats-io/nats.go"
+	"github.com/segmentio/kafka-go"
+	"github.com/spf13/viper"
+)
+
+// newPublisher creates the publisher for the configured publish mode
+func newPublisher(redisDB *redis.Client) (stk_app_v1.Publisher, error) {
+	switch mode := viper.GetString("STK_PUBLISH_MODE"); mode {
+	case "", stk_app_v1.PublishModePubSub:
+		return stk_app_v1.NewRedisPublisher(redisDB), nil
+	case stk_app_v1.PublishModeStreams:
+		return stk_app_v1.NewStreamsPublisher(redisDB, viper.GetInt64("STK_STREAM_MAX_LEN")), nil
+	case stk_app_v1.PublishModeNATS:
+		conn, err := nats.Connect(
+			firstVal(viper.GetString("STK_NATS_URL"), nats.DefaultURL),
+			nats.Name("mpesastk"),
+			nats.MaxReconnects(-1),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to nats: %v", err)
+		}
+		return stk_app_v1.NewNATSPublisher(conn), nil
+	case stk_app_v1.PublishModeKafka:
+		brokers := viper.GetStringSlice("STK_KAFKA_BROKERS")
+		if len(brokers) == 0 {
+			return nil, fmt.Errorf("missing kafka brokers")
+		}
+		return stk_app_v1.NewKafkaPublisher(&kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: viper.GetBool("STK_KAFKA_AUTO_CREATE_TOPICS"),
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown publish mode %q", mode)
+	}
+}
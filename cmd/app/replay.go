@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	stk_v1 "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// replayCallbacksCmd is the subcommand that replays stored stk callbacks
+const replayCallbacksCmd = "replay-callbacks"
+
+// runReplayCallbacks replays stored stk callbacks through the ReplayCallbacks RPC of a running service.
+//
+// Usage: app replay-callbacks -config-file .env -from 2022-11-01T00:00:00Z -to 2022-11-02T00:00:00Z -dry-run
+func runReplayCallbacks(args []string) error {
+	var (
+		fs          = flag.NewFlagSet(replayCallbacksCmd, flag.ExitOnError)
+		configFile  = fs.String("config-file", ".env", "Configuration file")
+		address     = fs.String("address", "", "Address of the stk gRPC server; defaults to localhost:<grpcPort>")
+		from        = fs.String("from", "", "Replay callbacks received from this time (RFC3339)")
+		to          = fs.String("to", "", "Replay callbacks received until this time (RFC3339)")
+		checkoutIDs = fs.String("checkout-ids", "", "Comma separated checkout request ids to replay")
+		dryRun      = fs.Bool("dry-run", false, "Show changes without applying them")
+		limit       = fs.Int("limit", 0, "Maximum number of callbacks to replay")
+		timeout     = fs.Duration("timeout", 5*time.Minute, "Timeout for the replay")
+	)
+
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	viper.SetConfigFile(*configFile)
+
+	err = viper.ReadInConfig()
+	if err != nil {
+		return err
+	}
+
+	filter := &stk_v1.ListStkCallbacksFilter{}
+
+	if *checkoutIDs != "" {
+		filter.CheckoutRequestIds = strings.Split(*checkoutIDs, ",")
+	}
+
+	if *from != "" {
+		t, err := time.Parse(time.RFC3339, *from)
+		if err != nil {
+			return fmt.Errorf("incorrect from time: %v", err)
+		}
+		filter.StartTimestamp = t.Unix()
+	}
+
+	if *to != "" {
+		t, err := time.Parse(time.RFC3339, *to)
+		if err != nil {
+			return fmt.Errorf("incorrect to time: %v", err)
+		}
+		filter.EndTimestamp = t.Unix()
+	}
+
+	jwtKey := viper.GetString("JWT_SIGNING_KEY")
+	if jwtKey == "" {
+		return errors.New("missing JWT key")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	// Admin token for calling the service
+	authAPI := auth.NewAPI([]byte(jwtKey), "ONFON MEDIA LIMITED", "apis")
+
+	token, err := authAPI.GenToken(ctx, &auth.Payload{
+		ID:        "1",
+		ProjectID: "-",
+		Names:     "replay_callbacks_cli",
+		Group:     auth.DefaultAdminGroup(),
+		Roles:     []string{},
+	}, time.Now().Add(*timeout))
+	if err != nil {
+		return fmt.Errorf("failed to generate auth token: %v", err)
+	}
+
+	creds := insecure.NewCredentials()
+	if viper.GetBool("tlsEnabled") {
+		creds, err = credentials.NewClientTLSFromFile(viper.GetString("tlsCert"), viper.GetString("tlsSubjectAltName"))
+		if err != nil {
+			return fmt.Errorf("failed to load tls certificate: %v", err)
+		}
+	}
+
+	cc, err := grpc.DialContext(ctx, firstVal(*address, fmt.Sprintf("localhost:%d", viper.GetInt("grpcPort"))),
+		grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return fmt.Errorf("failed to dial stk service: %v", err)
+	}
+	defer cc.Close()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), token))
+
+	res, err := stk_v1.NewStkPushV1Client(cc).ReplayCallbacks(ctx, &stk_v1.ReplayCallbacksRequest{
+		Filter: filter,
+		DryRun: *dryRun,
+		Limit:  int32(*limit),
+	})
+	if err != nil {
+		return err
+	}
+
+	bs, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(os.Stdout, string(bs))
+	return err
+}
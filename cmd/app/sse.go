@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	stk_v1 "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const sseKeepAliveInterval = 15 * time.Second
+
+// ServeWatchSSE streams stk transaction events as server-sent events.
+//
+// Query parameters are similar to /stk/v1:watch e.g ?filter.short_codes=174379&filter.stk_statuses=STK_SUCCESS
+func (gw *stkGateway) ServeWatchSSE(w http.ResponseWriter, r *http.Request) {
+	code, err := gw.serveWatchSSE(w, r)
+	if err != nil {
+		gw.Logger.Errorf("Error serving stk events: %v", err)
+		if code != 0 {
+			http.Error(w, err.Error(), code)
+		}
+	}
+}
+
+func (gw *stkGateway) serveWatchSSE(w http.ResponseWriter, r *http.Request) (int, error) {
+	if r.Method != http.MethodGet {
+		return http.StatusBadRequest, fmt.Errorf("bad method; only GET allowed; received %v method", r.Method)
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return http.StatusInternalServerError, fmt.Errorf("streaming not supported")
+	}
+
+	req := &stk_v1.WatchStkTransactionsRequest{}
+
+	err := runtime.PopulateQueryParameters(req, r.URL.Query(), &utilities.DoubleArray{})
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("failed to parse query parameters: %v", err)
+	}
+
+	// Caller credentials are passed to the stream
+	ctx := metadata.AppendToOutgoingContext(r.Context(), auth.Header(), r.Header.Get("Authorization"))
+
+	stream, err := gw.StkV1Client.WatchStkTransactions(ctx, req)
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to watch stk transactions: %v", err)
+	}
+
+	// Response header is sent once first event or error arrives from the stream
+	_, err = stream.Header()
+	if err != nil {
+		return runtime.HTTPStatusFromCode(status.Code(err)), fmt.Errorf("failed to watch stk transactions: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	var (
+		events = make(chan *stk_v1.WatchStkTransactionsResponse)
+		errCh  = make(chan error, 1)
+		ticker = time.NewTicker(sseKeepAliveInterval)
+	)
+	defer ticker.Stop()
+
+	go func() {
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, nil
+		case err := <-errCh:
+			return 0, fmt.Errorf("stk events stream closed: %v", err)
+		case <-ticker.C:
+			_, err := fmt.Fprint(w, ": keep-alive\n\n")
+			if err != nil {
+				return 0, nil
+			}
+			flusher.Flush()
+		case event := <-events:
+			bs, err := protojson.Marshal(event)
+			if err != nil {
+				return 0, fmt.Errorf("failed to json marshal stk event: %v", err)
+			}
+			_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.EventType.String(), bs)
+			if err != nil {
+				return 0, nil
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package stk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// BlobStore keeps files produced by export jobs
+type BlobStore interface {
+	// Put saves the content read from r under key
+	Put(ctx context.Context, key string, r io.Reader) error
+	// Open returns the content saved under key
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content saved under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// ErrBlobNotFound is returned when no content is saved under a key
+var ErrBlobNotFound = errors.New("blob not found")
+
+type localBlobStore struct {
+	dir string
+}
+
+// NewLocalBlobStore creates a blob store that saves files in a directory of the local filesystem.
+//
+// Files are only visible to the replica that wrote them, hence the store suits a single replica or a directory
+// shared by all replicas. Use an S3 store otherwise.
+func NewLocalBlobStore(dir string) (BlobStore, error) {
+	if dir == "" {
+		return nil, errors.New("missing blob store directory")
+	}
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %v", err)
+	}
+	return &localBlobStore{dir: dir}, nil
+}
+
+func (s *localBlobStore) path(key string) (string, error) {
+	name := filepath.Clean(filepath.FromSlash(key))
+	if name == "." || filepath.IsAbs(name) || strings.HasPrefix(name, "..") {
+		return "", fmt.Errorf("incorrect blob key %q", key)
+	}
+	return filepath.Join(s.dir, name), nil
+}
+
+func (s *localBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	// Content is written to a temporary file first so that readers never see a partial file
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = io.Copy(f, r)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func (s *localBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	return f, err
+}
+
+func (s *localBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+type s3BlobStore struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// NewS3BlobStore creates a blob store that saves files in a bucket of an S3 compatible object storage.
+// Keys are prefixed with prefix.
+func NewS3BlobStore(client *minio.Client, bucket, prefix string) (BlobStore, error) {
+	switch {
+	case client == nil:
+		return nil, errors.New("missing s3 client")
+	case bucket == "":
+		return nil, errors.New("missing s3 bucket")
+	}
+	return &s3BlobStore{client: client, bucket: bucket, prefix: prefix}, nil
+}
+
+func (s *s3BlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	// Unknown size is uploaded in parts as it is read
+	_, err := s.client.PutObject(ctx, s.bucket, s.prefix+key, r, -1, minio.PutObjectOptions{
+		ContentType: "application/gzip",
+	})
+	return err
+}
+
+func (s *s3BlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	_, err := s.client.StatObject(ctx, s.bucket, s.prefix+key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrBlobNotFound
+		}
+		return nil, err
+	}
+	return s.client.GetObject(ctx, s.bucket, s.prefix+key, minio.GetObjectOptions{})
+}
+
+func (s *s3BlobStore) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, s.prefix+key, minio.RemoveObjectOptions{})
+}
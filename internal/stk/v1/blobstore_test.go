@@ -0,0 +1,56 @@
+package stk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalBlobStore() error = %v", err)
+	}
+
+	const key = "exports/1/transactions.csv.gz"
+
+	err = store.Put(ctx, key, strings.NewReader("content"))
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	r, err := store.Open(ctx, key)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	bs, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || string(bs) != "content" {
+		t.Fatalf("Open() read %q (%v), want %q", bs, err, "content")
+	}
+
+	err = store.Delete(ctx, key)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	_, err = store.Open(ctx, key)
+	if !errors.Is(err, ErrBlobNotFound) {
+		t.Errorf("Open() after Delete() error = %v, want %v", err, ErrBlobNotFound)
+	}
+
+	// Deleting a missing key is not an error
+	err = store.Delete(ctx, key)
+	if err != nil {
+		t.Errorf("Delete() of missing key error = %v", err)
+	}
+
+	_, err = store.Open(ctx, "../outside")
+	if err == nil {
+		t.Error("Open() of key outside the directory error = nil, want error")
+	}
+}
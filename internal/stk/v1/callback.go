@@ -0,0 +1,222 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/gidyon/mpesastk/pkg/payload"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// APIServer is the stk service together with operations used by the http gateway
+type APIServer interface {
+	stk.StkPushV1Server
+	// HandleCallback archives stk callback from daraja and applies it to its transaction
+	HandleCallback(ctx context.Context, in *IncomingCallback) (*stk.StkTransaction, error)
+	// OpenExportFile verifies the signed download link of an export job and opens its file
+	OpenExportFile(ctx context.Context, jobID uint64, expires int64, signature string) (*ExportFile, error)
+}
+
+// ValidateCallback validates stk callback from daraja
+func ValidateCallback(callback *payload.STKPayload) error {
+	var err error
+	switch {
+	case callback == nil:
+		err = errs.MissingField("stk callback")
+	case callback.Body.STKCallback.CheckoutRequestID == "":
+		err = errs.MissingField("checkout id")
+	case callback.Body.STKCallback.MerchantRequestID == "":
+		err = errs.MissingField("merchant id")
+	case callback.Body.STKCallback.ResultDesc == "":
+		err = errs.MissingField("description")
+	}
+	return err
+}
+
+// errCallbackIgnored is returned when the callback does not change the transaction
+var errCallbackIgnored = errors.New("stk callback ignored")
+
+// processCallback applies stk callback to its transaction and saves the result to the outbox when requested
+func (stkAPI *stkAPIServer) processCallback(
+	ctx context.Context, callback *payload.STKPayload,
+) (*stk.StkTransaction, error) {
+	err := ValidateCallback(callback)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		cb        = callback.Body.STKCallback
+		db        = &STKTransaction{}
+		initReq   = &stk.InitiateSTKRequest{}
+		eventType = stk.StkTransactionEventType_STK_TRANSACTION_UPDATED
+		outbox    = stkAPI.newOutboxWriter(initReq)
+	)
+
+	// Get the request that initiated this STK
+	bs, err := stkAPI.RedisDB.Get(ctx, GetMpesaRequestKey(cb.CheckoutRequestID)).Result()
+	if err == nil {
+		err = proto.Unmarshal([]byte(bs), initReq)
+		if err != nil {
+			stkAPI.Logger.Errorln("Failed to unmarshal initiate stk request: ", err)
+		}
+	}
+
+	err = stkAPI.SQLDB.First(db, "checkout_request_id = ?", cb.CheckoutRequestID).Error
+	switch {
+	case err == nil:
+		// Update STK transaction
+		status, updates, err := callbackUpdates(db, callback)
+		if err != nil {
+			return nil, err
+		}
+		err = TransitionStatus(stkAPI.SQLDB, db, &StatusTransition{
+			To:          status,
+			Source:      stk.StkEventSource_STK_SOURCE_CALLBACK,
+			Description: cb.ResultDesc,
+			Updates:     updates,
+			Hooks:       []TxHook{outbox.hook},
+		})
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrIllegalTransition):
+			stkAPI.Logger.Warningf("stk callback for %s ignored: %v", cb.CheckoutRequestID, err)
+			pb, errProto := ToProto(db)
+			if errProto != nil {
+				return nil, errProto
+			}
+			return pb, fmt.Errorf("%w: %v", errCallbackIgnored, err)
+		default:
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to update stk transaction")
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		// Create STK transaction
+		db, err = callbackTransaction(initReq, callback)
+		if err != nil {
+			return nil, err
+		}
+		err = CreateTransaction(stkAPI.SQLDB, db, stk.StkEventSource_STK_SOURCE_CALLBACK, cb.ResultDesc, outbox.hook)
+		if err != nil {
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to create stk transaction")
+		}
+		eventType = stk.StkTransactionEventType_STK_TRANSACTION_CREATED
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+
+	pb, err := ToProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wake up waiters and watchers of the stk transaction
+	err = NotifyTransactionUpdate(ctx, stkAPI.RedisDB, eventType, pb)
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to notify stk transaction update: %v", err)
+	}
+
+	// Publish messages were saved with the update
+	outbox.flush()
+
+	return pb, nil
+}
+
+// callbackResult returns the status and succeeded state reported by the callback
+func callbackResult(cb *payload.STKCallback) (stk.StkStatus, string) {
+	if cb.ResultCode != 0 {
+		return stk.StkStatus_STK_FAILED, "NO"
+	}
+	return stk.StkStatus_STK_SUCCESS, "YES"
+}
+
+// callbackExtras returns callback metadata items without typed accessors as json
+func callbackExtras(cb *payload.STKCallback) (sql.NullString, error) {
+	items := cb.CallbackMetadata.UnknownItems()
+	if len(items) == 0 {
+		return sql.NullString{}, nil
+	}
+
+	// Items are kept as sent by daraja
+	bs, err := json.Marshal(items)
+	if err != nil {
+		return sql.NullString{}, errs.FromJSONMarshal(err, "callback extras")
+	}
+
+	return sql.NullString{String: string(bs), Valid: true}, nil
+}
+
+// callbackUpdates returns the status and columns that the callback sets on an existing transaction
+func callbackUpdates(db *STKTransaction, callback *payload.STKPayload) (stk.StkStatus, map[string]interface{}, error) {
+	var (
+		cb                = &callback.Body.STKCallback
+		status, succeeded = callbackResult(cb)
+		receiptID         = firstVal(cb.CallbackMetadata.MpesaReceiptNumber(), db.MpesaReceiptId.String)
+		balance           = cb.CallbackMetadata.Balance()
+	)
+
+	extras, err := callbackExtras(cb)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return status, map[string]interface{}{
+		"result_code":        sql.NullString{String: fmt.Sprint(cb.ResultCode), Valid: true},
+		"result_description": sql.NullString{String: cb.ResultDesc, Valid: cb.ResultDesc != ""},
+		"mpesa_receipt_id":   sql.NullString{String: receiptID, Valid: receiptID != ""},
+		"transaction_time":   sql.NullTime{Valid: true, Time: cb.CallbackMetadata.GetTransTime()},
+		"balance":            sql.NullString{String: balance, Valid: balance != ""},
+		"callback_extras":    extras,
+		"succeeded":          succeeded,
+	}, nil
+}
+
+// callbackTransaction returns a new transaction for a callback whose transaction is not saved
+func callbackTransaction(initReq *stk.InitiateSTKRequest, callback *payload.STKPayload) (*STKTransaction, error) {
+	var (
+		cb                = &callback.Body.STKCallback
+		status, succeeded = callbackResult(cb)
+		receiptID         = cb.CallbackMetadata.MpesaReceiptNumber()
+		balance           = cb.CallbackMetadata.Balance()
+	)
+
+	extras, err := callbackExtras(cb)
+	if err != nil {
+		return nil, err
+	}
+
+	return &STKTransaction{
+		ID:                         0,
+		InitiatorID:                initReq.GetInitiatorId(),
+		InitiatorCustomerReference: initReq.GetInitiatorCustomerReference(),
+		InitiatorCustomerNames:     initReq.GetInitiatorCustomerNames(),
+		PhoneNumber:                cb.CallbackMetadata.PhoneNumber(),
+		Amount:                     fmt.Sprint(cb.CallbackMetadata.GetAmount()),
+		ShortCode:                  initReq.GetPublishMessage().GetPayload()["short_code"],
+		AccountReference:           initReq.GetAccountReference(),
+		TransactionDesc:            sql.NullString{String: initReq.GetTransactionDesc(), Valid: initReq.GetTransactionDesc() != ""},
+		MerchantRequestID:          sql.NullString{String: cb.MerchantRequestID, Valid: cb.MerchantRequestID != ""},
+		CheckoutRequestID:          sql.NullString{String: cb.CheckoutRequestID, Valid: cb.CheckoutRequestID != ""},
+		ResultCode:                 sql.NullString{String: fmt.Sprint(cb.ResultCode), Valid: true},
+		ResultDescription:          sql.NullString{String: cb.ResultDesc, Valid: cb.ResultDesc != ""},
+		MpesaReceiptId:             sql.NullString{String: receiptID, Valid: receiptID != ""},
+		Balance:                    sql.NullString{String: balance, Valid: balance != ""},
+		CallbackExtras:             extras,
+		StkStatus:                  sql.NullString{String: status.String(), Valid: true},
+		TransactionType:            sql.NullString{String: initReq.GetTransactionType().String(), Valid: initReq.GetTransactionType() != stk.StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED},
+		Succeeded:                  succeeded,
+		Processed:                  "NO",
+		TransactionTime:            sql.NullTime{Valid: true, Time: cb.CallbackMetadata.GetTransTime()},
+		CreatedAt:                  time.Time{},
+	}, nil
+}
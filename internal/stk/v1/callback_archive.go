@@ -0,0 +1,359 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/gidyon/mpesastk/pkg/payload"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// IncomingCallback is a raw stk callback as received by the http gateway
+type IncomingCallback struct {
+	Body       []byte
+	Header     http.Header
+	RemoteAddr string
+	// Token is the callback token from the callback url
+	Token string
+}
+
+// STKCallback is a raw stk callback received from daraja
+type STKCallback struct {
+	ID                uint           `gorm:"primaryKey;autoIncrement"`
+	CheckoutRequestID sql.NullString `gorm:"index:idx_checkout_result_code;type:varchar(50)"`
+	MerchantRequestID sql.NullString `gorm:"type:varchar(50)"`
+	ResultCode        sql.NullString `gorm:"index:idx_checkout_result_code;type:varchar(10)"`
+	Body              string         `gorm:"type:text;not null"`
+	Headers           sql.NullString `gorm:"type:text"`
+	RemoteAddr        sql.NullString `gorm:"type:varchar(100)"`
+	CallbackTokenHash sql.NullString `gorm:"type:varchar(64)"`
+	State             string         `gorm:"index;type:varchar(30)"`
+	Error             sql.NullString `gorm:"type:varchar(300)"`
+	TransactionID     sql.NullInt64  `gorm:"index"`
+	UpdatedAt         time.Time      `gorm:"autoUpdateTime;type:datetime(6)"`
+	CreatedAt         time.Time      `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// StkCallbacksTable is table for raw stk callbacks
+const StkCallbacksTable = "stk_callbacks"
+
+// TableName returns the name of the table
+func (*STKCallback) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), StkCallbacksTable)
+	}
+	return StkCallbacksTable
+}
+
+// CallbackToProto returns the protobuf message of stk callback
+func CallbackToProto(db *STKCallback) (*stk.StkCallback, error) {
+	if db == nil {
+		return nil, errs.MissingField("stk callback")
+	}
+
+	pb := &stk.StkCallback{
+		CallbackId:        uint64(db.ID),
+		CheckoutRequestId: db.CheckoutRequestID.String,
+		MerchantRequestId: db.MerchantRequestID.String,
+		ResultCode:        db.ResultCode.String,
+		Body:              db.Body,
+		RemoteAddr:        db.RemoteAddr.String,
+		State:             stk.StkCallbackState(stk.StkCallbackState_value[db.State]),
+		Error:             db.Error.String,
+		TransactionId:     uint64(db.TransactionID.Int64),
+		CreateTimestamp:   db.CreatedAt.UTC().Unix(),
+	}
+
+	if db.Headers.String != "" {
+		err := json.Unmarshal([]byte(db.Headers.String), &pb.Headers)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "callback headers")
+		}
+	}
+
+	return pb, nil
+}
+
+// flattenHeader joins values of each header
+func flattenHeader(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for k, vals := range header {
+		headers[k] = strings.Join(vals, ", ")
+	}
+	return headers
+}
+
+func (stkAPI *stkAPIServer) HandleCallback(ctx context.Context, in *IncomingCallback) (*stk.StkTransaction, error) {
+	// Validation
+	switch {
+	case in == nil:
+		return nil, errs.MissingField("callback")
+	case len(in.Body) == 0:
+		return nil, errs.MissingField("callback body")
+	}
+
+	headers, err := json.Marshal(flattenHeader(in.Header))
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "callback headers")
+	}
+
+	// Archive the callback before anything else so that it is never lost
+	db := &STKCallback{
+		Body:       string(in.Body),
+		Headers:    sql.NullString{String: string(headers), Valid: true},
+		RemoteAddr: sql.NullString{String: in.RemoteAddr, Valid: in.RemoteAddr != ""},
+		State:      stk.StkCallbackState_STK_CALLBACK_RECEIVED.String(),
+	}
+	if in.Token != "" {
+		db.CallbackTokenHash = sql.NullString{String: hashCallbackToken(in.Token), Valid: true}
+	}
+
+	err = stkAPI.SQLDB.Create(db).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to save stk callback")
+	}
+
+	callback := &payload.STKPayload{}
+
+	err = json.Unmarshal(in.Body, callback)
+	if err == nil {
+		err = ValidateCallback(callback)
+	}
+	if err != nil {
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_FAILED, nil, err)
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect stk callback")
+	}
+
+	cb := callback.Body.STKCallback
+
+	err = stkAPI.SQLDB.Model(db).Updates(map[string]interface{}{
+		"checkout_request_id": cb.CheckoutRequestID,
+		"merchant_request_id": cb.MerchantRequestID,
+		"result_code":         fmt.Sprint(cb.ResultCode),
+	}).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to update stk callback")
+	}
+
+	// Repeated callbacks are acknowledged without being applied again
+	original := &STKCallback{}
+	err = stkAPI.SQLDB.Order("id ASC").First(original, "checkout_request_id = ? AND result_code = ? AND state = ? AND id <> ?",
+		cb.CheckoutRequestID, fmt.Sprint(cb.ResultCode), stk.StkCallbackState_STK_CALLBACK_PROCESSED.String(), db.ID).Error
+	switch {
+	case err == nil:
+		stkAPI.Logger.Infof("duplicate stk callback for %s acknowledged", cb.CheckoutRequestID)
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_DUPLICATE, nil,
+			fmt.Errorf("duplicate of callback %d", original.ID))
+		return stkAPI.appliedTransaction(original)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk callback")
+	}
+
+	err = stkAPI.verifyCallback(ctx, callback, db.CallbackTokenHash.String)
+	if err != nil {
+		state := stk.StkCallbackState_STK_CALLBACK_FAILED
+		if status.Code(err) == codes.PermissionDenied {
+			state = stk.StkCallbackState_STK_CALLBACK_REJECTED
+			stkAPI.Logger.Warningf("stk callback %d for %s rejected: %v", db.ID, cb.CheckoutRequestID, err)
+		}
+		stkAPI.finishCallback(db, state, nil, err)
+		return nil, err
+	}
+
+	pb, err := stkAPI.processCallback(ctx, callback)
+	switch {
+	case err == nil:
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_PROCESSED, pb, nil)
+	case errors.Is(err, errCallbackIgnored):
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_PROCESSED, pb, err)
+	default:
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_FAILED, nil, err)
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+// finishCallback saves the outcome of processing stk callback
+func (stkAPI *stkAPIServer) finishCallback(db *STKCallback, state stk.StkCallbackState, pb *stk.StkTransaction, errProcess error) {
+	updates := map[string]interface{}{
+		"state": state.String(),
+	}
+	if pb != nil {
+		updates["transaction_id"] = pb.TransactionId
+	}
+	if errProcess != nil {
+		updates["error"] = truncate(errProcess.Error(), 300)
+	}
+
+	err := stkAPI.SQLDB.Model(db).Updates(updates).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to update stk callback %d: %v", db.ID, err)
+	}
+}
+
+// appliedTransaction returns the transaction that the callback was applied to
+func (stkAPI *stkAPIServer) appliedTransaction(db *STKCallback) (*stk.StkTransaction, error) {
+	tx := &STKTransaction{}
+	err := stkAPI.SQLDB.First(tx, "id = ?", db.TransactionID.Int64).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+	return ToProto(tx)
+}
+
+func (stkAPI *stkAPIServer) GetStkCallback(
+	ctx context.Context, req *stk.GetStkCallbackRequest,
+) (*stk.StkCallback, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request")
+	case req.CallbackId == 0:
+		return nil, errs.MissingField("callback id")
+	}
+
+	db := &STKCallback{}
+	err = stkAPI.SQLDB.First(db, "id = ?", req.CallbackId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("stk callback", fmt.Sprint(req.CallbackId))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk callback")
+	}
+
+	return CallbackToProto(db)
+}
+
+func (stkAPI *stkAPIServer) ListStkCallbacks(
+	ctx context.Context, req *stk.ListStkCallbacksRequest,
+) (*stk.ListStkCallbacksResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	db := stkAPI.SQLDB.Model(&STKCallback{}).Order("id DESC").Limit(int(pageSize) + 1)
+	if id != 0 {
+		db = db.Where("id < ?", id)
+	}
+
+	db = filterCallbacks(db, req.GetFilter())
+
+	dbs := make([]*STKCallback, 0, pageSize+1)
+
+	err = db.Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list stk callbacks")
+	}
+
+	pbs := make([]*stk.StkCallback, 0, len(dbs))
+
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := CallbackToProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+		id = uint64(db.ID)
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(id)))
+	}
+
+	return &stk.ListStkCallbacksResponse{
+		NextPageToken: token,
+		Callbacks:     pbs,
+	}, nil
+}
+
+// filterCallbacks applies the filter to callbacks query
+func filterCallbacks(db *gorm.DB, filter *stk.ListStkCallbacksFilter) *gorm.DB {
+	if filter == nil {
+		return db
+	}
+
+	if len(filter.CheckoutRequestIds) > 0 {
+		db = db.Where("checkout_request_id IN(?)", filter.CheckoutRequestIds)
+	}
+
+	if len(filter.States) > 0 {
+		ss := make([]string, 0, len(filter.States))
+		for _, s := range filter.States {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("state IN(?)", ss)
+	}
+
+	if filter.StartTimestamp > 0 {
+		db = db.Where("created_at >= ?", time.Unix(filter.StartTimestamp, 0))
+	}
+
+	if filter.EndTimestamp > 0 {
+		db = db.Where("created_at <= ?", time.Unix(filter.EndTimestamp, 0))
+	}
+
+	return db
+}
@@ -0,0 +1,163 @@
+package stk
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesastk/pkg/payload"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// CallbackTokenParam is the query parameter in callback url that carries the transaction callback token
+const CallbackTokenParam = "token"
+
+// hashCallbackToken returns the hash of callback token that is saved with the transaction
+func hashCallbackToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// withCallbackToken adds the callback token to the callback url
+func withCallbackToken(callbackURL, token string) (string, error) {
+	u, err := url.Parse(callbackURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse callback url: %v", err)
+	}
+
+	q := u.Query()
+	q.Set(CallbackTokenParam, token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func amountMatches(amount string, callbackAmount float32) bool {
+	v, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return false
+	}
+	return math.Abs(v-float64(callbackAmount)) < 0.01
+}
+
+// verifyCallback checks that the callback came from daraja. A callback with a wrong token is always rejected.
+// Other suspicious callbacks are cross-checked against the stk query API when enabled.
+//
+// The token is passed as its hash, as saved with the archived callback, so that replays are verified the same way.
+func (stkAPI *stkAPIServer) verifyCallback(ctx context.Context, callback *payload.STKPayload, tokenHash string) error {
+	if !stkAPI.CallbackTokenEnabled && !stkAPI.CrossCheckCallbacks {
+		return nil
+	}
+
+	var (
+		cb        = callback.Body.STKCallback
+		db        = &STKTransaction{}
+		found     bool
+		suspicion string
+		decisive  bool
+	)
+
+	err := stkAPI.SQLDB.First(db, "checkout_request_id = ?", cb.CheckoutRequestID).Error
+	switch {
+	case err == nil:
+		found = true
+		switch {
+		case stkAPI.CallbackTokenEnabled && db.CallbackTokenHash.Valid &&
+			subtle.ConstantTimeCompare([]byte(db.CallbackTokenHash.String), []byte(tokenHash)) != 1:
+			suspicion, decisive = "incorrect callback token", true
+		case cb.ResultCode == 0 && !amountMatches(db.Amount, cb.CallbackMetadata.GetAmount()):
+			suspicion = "callback amount differs from transaction amount"
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		suspicion, decisive = "no stk transaction for checkout id", stkAPI.CallbackTokenEnabled
+	default:
+		stkAPI.Logger.Errorln(err)
+		return errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+
+	if suspicion == "" {
+		return nil
+	}
+
+	// Anyone who knows the checkout id can ask daraja for the result code, hence the query cannot vouch for
+	// a callback that failed token verification
+	if decisive {
+		return errs.WrapMessagef(codes.PermissionDenied, "stk callback rejected: %s", suspicion)
+	}
+
+	if !stkAPI.CrossCheckCallbacks {
+		stkAPI.Logger.Warningf("suspicious stk callback for %s accepted: %s", cb.CheckoutRequestID, suspicion)
+		return nil
+	}
+
+	// Daraja knows the real outcome of the stk push
+	cred, err := stkAPI.credentials.Get(db.ShortCode)
+	if err != nil {
+		return err
+	}
+
+	resData, _, err := stkAPI.queryStk(ctx, cred, cb.CheckoutRequestID)
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to cross-check stk callback for %s: %v", cb.CheckoutRequestID, err)
+		return errs.WrapMessagef(codes.PermissionDenied, "stk callback rejected: %s and cross-check failed", suspicion)
+	}
+
+	err = stkAPI.crossCheckCallback(&cb, resData, db, found)
+	if err != nil {
+		return errs.WrapMessagef(codes.PermissionDenied, "stk callback rejected: %s and %v", suspicion, err)
+	}
+
+	stkAPI.Logger.Infof("suspicious stk callback for %s confirmed by stk query: %s", cb.CheckoutRequestID, suspicion)
+
+	return nil
+}
+
+// crossCheckCallback compares the callback with the stk query result and the saved transaction.
+//
+// The stk query does not report amount or receipt, so a successful callback must also carry the amount of
+// the transaction and a receipt that is not saved with another transaction.
+func (stkAPI *stkAPIServer) crossCheckCallback(
+	cb *payload.STKCallback, resData *payload.QueryStkResponse, db *STKTransaction, found bool,
+) error {
+	switch {
+	case resData.ResultCode != fmt.Sprint(cb.ResultCode):
+		return fmt.Errorf("result code %d differs from query result code %s", cb.ResultCode, resData.ResultCode)
+	case resData.MerchantRequestID != cb.MerchantRequestID:
+		return fmt.Errorf("merchant request id %s differs from query merchant request id %s", cb.MerchantRequestID, resData.MerchantRequestID)
+	case cb.ResultCode != 0:
+		return nil
+	}
+
+	if found && !amountMatches(db.Amount, cb.CallbackMetadata.GetAmount()) {
+		return fmt.Errorf("amount %v differs from transaction amount %s", cb.CallbackMetadata.GetAmount(), db.Amount)
+	}
+
+	receipt := cb.CallbackMetadata.MpesaReceiptNumber()
+	if receipt == "" {
+		return errors.New("receipt is missing")
+	}
+
+	if found && db.MpesaReceiptId.String != "" && db.MpesaReceiptId.String != receipt {
+		return fmt.Errorf("receipt %s differs from transaction receipt %s", receipt, db.MpesaReceiptId.String)
+	}
+
+	var count int64
+	err := stkAPI.SQLDB.Model(&STKTransaction{}).
+		Where("mpesa_receipt_id = ? AND checkout_request_id <> ?", receipt, cb.CheckoutRequestID).Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to check receipt: %v", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("receipt %s belongs to another transaction", receipt)
+	}
+
+	return nil
+}
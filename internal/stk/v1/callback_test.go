@@ -0,0 +1,51 @@
+package stk
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gidyon/mpesastk/pkg/payload"
+)
+
+func TestCallbackExtras(t *testing.T) {
+	tests := []struct {
+		file   string
+		extras string
+		valid  bool
+	}{
+		{file: "success.json"},
+		{file: "reordered.json"},
+		{file: "missing_items.json"},
+		{file: "failed.json"},
+		{
+			file:   "extra_items.json",
+			extras: `{"ChargeAmount":2.5,"MerchantAccount":"ACME","PromoCode":null}`,
+			valid:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			bs, err := os.ReadFile(filepath.Join("..", "..", "..", "pkg", "payload", "testdata", tt.file))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", tt.file, err)
+			}
+
+			callback := &payload.STKPayload{}
+			err = json.Unmarshal(bs, callback)
+			if err != nil {
+				t.Fatalf("failed to unmarshal %s: %v", tt.file, err)
+			}
+
+			got, err := callbackExtras(&callback.Body.STKCallback)
+			if err != nil {
+				t.Fatalf("callbackExtras() error = %v", err)
+			}
+			if got.Valid != tt.valid || got.String != tt.extras {
+				t.Errorf("callbackExtras() = %q (valid %v), want %q (valid %v)", got.String, got.Valid, tt.extras, tt.valid)
+			}
+		})
+	}
+}
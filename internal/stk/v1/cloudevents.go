@@ -0,0 +1,200 @@
+package stk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Event formats decide how publish messages are encoded
+const (
+	// EventFormatRaw publishes the bare PublishMessage; protobuf for channels and json for webhooks
+	EventFormatRaw = ""
+	// EventFormatCloudEventsJSON wraps publish messages in CloudEvents 1.0 json structured mode
+	EventFormatCloudEventsJSON = "cloudevents-json"
+	// EventFormatCloudEventsProto wraps publish messages in CloudEvents 1.0 protobuf format.
+	// Webhooks are sent in json structured mode since they are meant for http consumers.
+	EventFormatCloudEventsProto = "cloudevents-proto"
+)
+
+// Content types of CloudEvents
+const (
+	CloudEventsJSONContentType  = "application/cloudevents+json"
+	CloudEventsProtoContentType = "application/cloudevents+protobuf"
+)
+
+// CloudEvents types of stk transaction events
+const (
+	EventTypeTransactionSubmitted = "stk.transaction.submitted"
+	EventTypeTransactionRequested = "stk.transaction.requested"
+	EventTypeTransactionSucceeded = "stk.transaction.succeeded"
+	EventTypeTransactionFailed    = "stk.transaction.failed"
+	EventTypeTransactionUpdated   = "stk.transaction.updated"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	defaultEventSource     = "/mpesastk/stk/v1"
+)
+
+func validEventFormat(format string) bool {
+	switch format {
+	case EventFormatRaw, EventFormatCloudEventsJSON, EventFormatCloudEventsProto:
+		return true
+	}
+	return false
+}
+
+// EventType returns the CloudEvents type of the transaction state
+func EventType(pb *stk.StkTransaction) string {
+	switch pb.GetStatus() {
+	case stk.StkStatus_STK_SUCCESS, stk.StkStatus_STK_RESULT_SUCCESS:
+		return EventTypeTransactionSucceeded
+	case stk.StkStatus_STK_FAILED, stk.StkStatus_STK_RESULT_FAILED, stk.StkStatus_STK_REQUEST_FAILED:
+		return EventTypeTransactionFailed
+	case stk.StkStatus_STK_REQUEST_SUBMITED:
+		return EventTypeTransactionSubmitted
+	case stk.StkStatus_STK_REQUEST_SUCCESS:
+		return EventTypeTransactionRequested
+	default:
+		return EventTypeTransactionUpdated
+	}
+}
+
+// EventID returns the id of the event for the message. The id only depends on the transaction state
+// so that redelivery of the same state, on any channel, carries the same id and consumers can deduplicate.
+func EventID(msg *stk.PublishMessage) string {
+	pb := msg.GetTransactionInfo()
+	sum := sha256.Sum256([]byte(fmt.Sprintf(
+		"%d:%s:%s:%t", msg.GetTransactionId(), EventType(pb), pb.GetStatus(), pb.GetProcessed(),
+	)))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (stkAPI *stkAPIServer) eventSource() string {
+	return firstVal(stkAPI.EventSource, defaultEventSource)
+}
+
+// eventTime is when the transaction reached the published state
+func (stkAPI *stkAPIServer) eventTime(msg *stk.PublishMessage) time.Time {
+	pb := msg.GetTransactionInfo()
+	switch {
+	case pb.GetUpdateTimestamp() > 0:
+		return time.Unix(pb.GetUpdateTimestamp(), 0).UTC()
+	case pb.GetCreateTimestamp() > 0:
+		return time.Unix(pb.GetCreateTimestamp(), 0).UTC()
+	default:
+		return stkAPI.NowFunc().UTC()
+	}
+}
+
+// cloudEventJSON is CloudEvents json structured mode envelope
+type cloudEventJSON struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// encodeMessage encodes the publish message for channel publishers
+func (stkAPI *stkAPIServer) encodeMessage(msg *stk.PublishMessage) ([]byte, error) {
+	switch stkAPI.EventFormat {
+	case EventFormatCloudEventsJSON:
+		return stkAPI.cloudEventJSON(msg)
+	case EventFormatCloudEventsProto:
+		return stkAPI.cloudEventProto(msg)
+	default:
+		bs, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, errs.FromProtoMarshal(err, "publish message")
+		}
+		return bs, nil
+	}
+}
+
+// encodeWebhookMessage encodes the publish message for webhooks
+func (stkAPI *stkAPIServer) encodeWebhookMessage(msg *stk.PublishMessage) ([]byte, error) {
+	if stkAPI.EventFormat == EventFormatRaw {
+		bs, err := protojson.Marshal(msg)
+		if err != nil {
+			return nil, errs.FromProtoMarshal(err, "publish message")
+		}
+		return bs, nil
+	}
+	return stkAPI.cloudEventJSON(msg)
+}
+
+// webhookContentType is the content type of webhook payloads
+func (stkAPI *stkAPIServer) webhookContentType() string {
+	if stkAPI.EventFormat == EventFormatRaw {
+		return "application/json"
+	}
+	return CloudEventsJSONContentType
+}
+
+func (stkAPI *stkAPIServer) cloudEventJSON(msg *stk.PublishMessage) ([]byte, error) {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, errs.FromProtoMarshal(err, "publish message")
+	}
+
+	bs, err := json.Marshal(&cloudEventJSON{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              EventID(msg),
+		Source:          stkAPI.eventSource(),
+		Type:            EventType(msg.GetTransactionInfo()),
+		Subject:         fmt.Sprint(msg.GetTransactionId()),
+		Time:            stkAPI.eventTime(msg).Format(time.RFC3339),
+		DataContentType: "application/json",
+		Data:            data,
+	})
+	if err != nil {
+		return nil, errs.FromJSONMarshal(err, "cloud event")
+	}
+
+	return bs, nil
+}
+
+func (stkAPI *stkAPIServer) cloudEventProto(msg *stk.PublishMessage) ([]byte, error) {
+	data, err := anypb.New(msg)
+	if err != nil {
+		return nil, errs.FromProtoMarshal(err, "publish message")
+	}
+
+	stringAttr := func(v string) *stk.CloudEventAttributeValue {
+		return &stk.CloudEventAttributeValue{Attr: &stk.CloudEventAttributeValue_CeString{CeString: v}}
+	}
+
+	bs, err := proto.Marshal(&stk.CloudEvent{
+		Id:          EventID(msg),
+		Source:      stkAPI.eventSource(),
+		SpecVersion: cloudEventsSpecVersion,
+		Type:        EventType(msg.GetTransactionInfo()),
+		Attributes: map[string]*stk.CloudEventAttributeValue{
+			"subject": stringAttr(fmt.Sprint(msg.GetTransactionId())),
+			"time": {Attr: &stk.CloudEventAttributeValue_CeTimestamp{
+				CeTimestamp: timestamppb.New(stkAPI.eventTime(msg)),
+			}},
+			"datacontenttype": stringAttr("application/protobuf"),
+		},
+		Data: &stk.CloudEvent_ProtoData{ProtoData: data},
+	})
+	if err != nil {
+		return nil, errs.FromProtoMarshal(err, "cloud event")
+	}
+
+	return bs, nil
+}
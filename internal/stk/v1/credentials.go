@@ -0,0 +1,188 @@
+package stk
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// ShortCodeCredential contains credentials for sending stk push on behalf of a shortcode
+type ShortCodeCredential struct {
+	ID               uint      `gorm:"primaryKey;autoIncrement" json:"-"`
+	ShortCode        string    `gorm:"uniqueIndex;type:varchar(15);not null" json:"short_code"`
+	PassKey          string    `gorm:"type:varchar(100);not null" json:"pass_key"`
+	ConsumerKey      string    `gorm:"type:varchar(100);not null" json:"consumer_key"`
+	ConsumerSecret   string    `gorm:"type:varchar(100);not null" json:"consumer_secret"`
+	CallBackURL      string    `gorm:"type:varchar(300)" json:"callback_url"`
+	TransactionType  string    `gorm:"type:varchar(30)" json:"transaction_type"`
+	TillNumber       string    `gorm:"type:varchar(15)" json:"till_number"`
+	AccountReference string    `gorm:"type:varchar(50)" json:"account_reference"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime;type:datetime(6)" json:"-"`
+	CreatedAt        time.Time `gorm:"autoCreateTime;type:datetime(6);not null" json:"-"`
+}
+
+// CredentialsTable is table for shortcode credentials
+const CredentialsTable = "stk_shortcode_credentials"
+
+// TableName returns the name of the table
+func (*ShortCodeCredential) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), CredentialsTable)
+	}
+	return CredentialsTable
+}
+
+// ValidateShortCodeCredential validates shortcode credential
+func ValidateShortCodeCredential(cred *ShortCodeCredential) error {
+	var err error
+	switch {
+	case cred == nil:
+		err = errs.MissingField("shortcode credential")
+	case cred.ShortCode == "":
+		err = errs.MissingField("short code")
+	case cred.PassKey == "":
+		err = errs.MissingField("pass key")
+	case cred.ConsumerKey == "":
+		err = errs.MissingField("consumer key")
+	case cred.ConsumerSecret == "":
+		err = errs.MissingField("consumer secret")
+	case cred.TransactionType != "" && ParseTransactionType(cred.TransactionType) == stk.StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED:
+		err = errs.IncorrectVal("transaction type")
+	}
+	return err
+}
+
+// transactionType returns the default transaction type for the shortcode
+func (cred *ShortCodeCredential) transactionType() stk.StkTransactionType {
+	if t := ParseTransactionType(cred.TransactionType); t != stk.StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED {
+		return t
+	}
+	return stk.StkTransactionType_CUSTOMER_PAYBILL_ONLINE
+}
+
+func (cred *ShortCodeCredential) basicToken() string {
+	return base64.StdEncoding.EncodeToString([]byte(cred.ConsumerKey + ":" + cred.ConsumerSecret))
+}
+
+func (cred *ShortCodeCredential) password(timestamp string) string {
+	return base64.StdEncoding.EncodeToString([]byte(cred.ShortCode + cred.PassKey + timestamp))
+}
+
+// LoadCredentialsFromFile reads shortcode credentials from a JSON file containing an array of credentials
+func LoadCredentialsFromFile(path string) ([]*ShortCodeCredential, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %v", err)
+	}
+
+	creds := make([]*ShortCodeCredential, 0)
+
+	err = json.Unmarshal(bs, &creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to json unmarshal credentials file: %v", err)
+	}
+
+	return creds, nil
+}
+
+// CredentialRegistry resolves stk credentials by shortcode
+type CredentialRegistry struct {
+	mu               sync.RWMutex
+	creds            map[string]*ShortCodeCredential
+	defaultShortCode string
+}
+
+// NewCredentialRegistry creates a registry of shortcode credentials. The first credential is the default.
+func NewCredentialRegistry(creds ...*ShortCodeCredential) (*CredentialRegistry, error) {
+	r := &CredentialRegistry{
+		creds: make(map[string]*ShortCodeCredential, len(creds)),
+	}
+
+	for _, cred := range creds {
+		err := r.Add(cred)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Add adds or replaces credentials for a shortcode
+func (r *CredentialRegistry) Add(cred *ShortCodeCredential) error {
+	err := ValidateShortCodeCredential(cred)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.creds[cred.ShortCode] = cred
+
+	if r.defaultShortCode == "" {
+		r.defaultShortCode = cred.ShortCode
+	}
+
+	return nil
+}
+
+// Get returns credentials for the shortcode or default credentials when shortcode is empty
+func (r *CredentialRegistry) Get(shortCode string) (*ShortCodeCredential, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cred, ok := r.creds[firstVal(shortCode, r.defaultShortCode)]
+	if !ok {
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "no credentials registered for short code %q", shortCode)
+	}
+
+	return cred, nil
+}
+
+// List returns all registered credentials sorted by shortcode
+func (r *CredentialRegistry) List() []*ShortCodeCredential {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	creds := make([]*ShortCodeCredential, 0, len(r.creds))
+	for _, cred := range r.creds {
+		creds = append(creds, cred)
+	}
+
+	sort.Slice(creds, func(i, j int) bool {
+		return creds[i].ShortCode < creds[j].ShortCode
+	})
+
+	return creds
+}
+
+// LoadFromDB adds credentials stored in the shortcode credentials table
+func (r *CredentialRegistry) LoadFromDB(db *gorm.DB) (int, error) {
+	creds := make([]*ShortCodeCredential, 0)
+
+	err := db.Find(&creds).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get shortcode credentials: %v", err)
+	}
+
+	for _, cred := range creds {
+		err = r.Add(cred)
+		if err != nil {
+			return 0, fmt.Errorf("invalid credentials for short code %q: %v", cred.ShortCode, err)
+		}
+	}
+
+	return len(creds), nil
+}
@@ -0,0 +1,171 @@
+package stk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// transactionsCursor is the position after the last transaction of a page
+type transactionsCursor struct {
+	// OrderField and Ascending are the sorting the cursor was issued for
+	OrderField stk.StkOrderField `json:"f"`
+	Ascending  bool              `json:"a,omitempty"`
+	// Value is the order column value of the last transaction in RFC3339 format; Null is set when it has no value
+	Value string `json:"v,omitempty"`
+	Null  bool   `json:"n,omitempty"`
+	ID    uint   `json:"i"`
+	// Filter is the hash of the filter the cursor was issued for
+	Filter string `json:"h"`
+}
+
+// transactionsOrder returns the column transactions are sorted by
+func transactionsOrder(filter *stk.ListStkTransactionFilter) (stk.StkOrderField, string, bool) {
+	ascending := filter.GetSortOrder() == stk.StkSortOrder_STK_SORT_ASCENDING
+	switch filter.GetOrderField() {
+	case stk.StkOrderField_TRANSACTION_TIMESTAMP:
+		return stk.StkOrderField_TRANSACTION_TIMESTAMP, "transaction_time", ascending
+	default:
+		return stk.StkOrderField_CREATE_TIMESTAMP, "created_at", ascending
+	}
+}
+
+// filterHash identifies the filter a cursor belongs to
+func filterHash(filter *stk.ListStkTransactionFilter) (string, error) {
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return "", errs.FromProtoMarshal(err, "filter")
+	}
+	sum := sha256.Sum256(bs)
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
+
+func (stkAPI *stkAPIServer) signCursor(payload []byte) []byte {
+	mac := hmac.New(sha256.New, stkAPI.pageTokenKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// encodeCursor returns the opaque page token of the cursor
+func (stkAPI *stkAPIServer) encodeCursor(c *transactionsCursor) (string, error) {
+	bs, err := json.Marshal(c)
+	if err != nil {
+		return "", errs.FromJSONMarshal(err, "page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(bs) + "." + base64.RawURLEncoding.EncodeToString(stkAPI.signCursor(bs)), nil
+}
+
+// decodeCursor verifies the page token and checks that it was issued for the filter
+func (stkAPI *stkAPIServer) decodeCursor(token string, filter *stk.ListStkTransactionFilter) (*transactionsCursor, error) {
+	incorrect := errs.WrapMessage(codes.InvalidArgument, "incorrect page token")
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, incorrect
+	}
+
+	bs, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, incorrect
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, incorrect
+	}
+
+	if !hmac.Equal(sig, stkAPI.signCursor(bs)) {
+		return nil, incorrect
+	}
+
+	c := &transactionsCursor{}
+	err = json.Unmarshal(bs, c)
+	if err != nil {
+		return nil, incorrect
+	}
+
+	hash, err := filterHash(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	orderField, _, ascending := transactionsOrder(filter)
+
+	if c.Filter != hash || c.OrderField != orderField || c.Ascending != ascending {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "page token was issued for a different filter")
+	}
+
+	return c, nil
+}
+
+// cursorAfter returns the cursor positioned at the transaction
+func cursorAfter(db *STKTransaction, filter *stk.ListStkTransactionFilter, hash string) *transactionsCursor {
+	orderField, _, ascending := transactionsOrder(filter)
+
+	c := &transactionsCursor{
+		OrderField: orderField,
+		Ascending:  ascending,
+		ID:         db.ID,
+		Filter:     hash,
+	}
+
+	switch orderField {
+	case stk.StkOrderField_TRANSACTION_TIMESTAMP:
+		if db.TransactionTime.Valid {
+			c.Value = db.TransactionTime.Time.UTC().Format(time.RFC3339Nano)
+		} else {
+			c.Null = true
+		}
+	default:
+		c.Value = db.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return c
+}
+
+// keysetPage orders the query by (order column, id) and applies the cursor.
+//
+// NULL order values sort before any value as in MySQL, hence they come last in descending order.
+func keysetPage(db *gorm.DB, filter *stk.ListStkTransactionFilter, c *transactionsCursor) (*gorm.DB, error) {
+	_, col, ascending := transactionsOrder(filter)
+
+	dir := "DESC"
+	if ascending {
+		dir = "ASC"
+	}
+	db = db.Order(col + " " + dir).Order("id " + dir)
+
+	if c == nil {
+		return db, nil
+	}
+
+	var val time.Time
+	if !c.Null {
+		var err error
+		val, err = time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, errs.WrapMessage(codes.InvalidArgument, "incorrect page token")
+		}
+	}
+
+	switch {
+	case !ascending && !c.Null:
+		db = db.Where("("+col+" < ? OR ("+col+" = ? AND id < ?) OR "+col+" IS NULL)", val, val, c.ID)
+	case !ascending && c.Null:
+		db = db.Where(col+" IS NULL AND id < ?", c.ID)
+	case ascending && !c.Null:
+		db = db.Where("("+col+" > ? OR ("+col+" = ? AND id > ?))", val, val, c.ID)
+	default:
+		db = db.Where("(("+col+" IS NULL AND id > ?) OR "+col+" IS NOT NULL)", c.ID)
+	}
+
+	return db, nil
+}
@@ -0,0 +1,345 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultOutboxMaxAttempts    = 20
+	defaultProcessMaxDeliveries = 5
+	maxRetryDeadLetters         = 100
+)
+
+// STKDeadLetter is a message that could not be published or processed after all its attempts
+type STKDeadLetter struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	Kind          string         `gorm:"index;type:varchar(30);not null"`
+	Channel       string         `gorm:"type:varchar(100)"`
+	Payload       []byte         `gorm:"type:blob"`
+	Error         sql.NullString `gorm:"type:varchar(300)"`
+	Attempts      int32          `gorm:"type:int;not null;default:0"`
+	TransactionID sql.NullInt64  `gorm:"index;type:int"`
+	SourceID      string         `gorm:"index;type:varchar(50)"`
+	UpdatedAt     time.Time      `gorm:"autoUpdateTime;type:datetime(6)"`
+	CreatedAt     time.Time      `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// DeadLettersTable is table for dead letters
+const DeadLettersTable = "stk_dead_letters"
+
+// TableName returns the name of the table
+func (*STKDeadLetter) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), DeadLettersTable)
+	}
+	return DeadLettersTable
+}
+
+// DeadLetterToProto returns the protobuf message of dead letter
+func DeadLetterToProto(db *STKDeadLetter) (*stk.DeadLetter, error) {
+	if db == nil {
+		return nil, errs.MissingField("dead letter")
+	}
+
+	return &stk.DeadLetter{
+		DeadLetterId:    uint64(db.ID),
+		Kind:            stk.DeadLetterKind(stk.DeadLetterKind_value[db.Kind]),
+		Channel:         db.Channel,
+		Payload:         db.Payload,
+		Error:           db.Error.String,
+		Attempts:        db.Attempts,
+		TransactionId:   uint64(db.TransactionID.Int64),
+		SourceId:        db.SourceID,
+		CreateTimestamp: db.CreatedAt.UTC().Unix(),
+		UpdateTimestamp: db.UpdatedAt.UTC().Unix(),
+	}, nil
+}
+
+// saveDeadLetter records a message that could not be handled
+func (stkAPI *stkAPIServer) saveDeadLetter(tx *gorm.DB, db *STKDeadLetter, cause error) error {
+	if cause != nil {
+		db.Error = sql.NullString{String: truncate(cause.Error(), 300), Valid: true}
+	}
+	err := tx.Create(db).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to save %s dead letter: %v", db.Kind, err)
+		return err
+	}
+	stkAPI.Logger.Warningf("%s message dead lettered after %d attempts: %v", db.Kind, db.Attempts, cause)
+	return nil
+}
+
+func (stkAPI *stkAPIServer) outboxMaxAttempts() int32 {
+	if stkAPI.OutboxMaxAttempts > 0 {
+		return int32(stkAPI.OutboxMaxAttempts)
+	}
+	return defaultOutboxMaxAttempts
+}
+
+func (stkAPI *stkAPIServer) processMaxDeliveries() int64 {
+	if stkAPI.ProcessMaxDeliveries > 0 {
+		return int64(stkAPI.ProcessMaxDeliveries)
+	}
+	return defaultProcessMaxDeliveries
+}
+
+func (stkAPI *stkAPIServer) updateDeadLetterMetrics() {
+	type kindCount struct {
+		Kind  string
+		Count int64
+	}
+
+	counts := make([]*kindCount, 0)
+
+	err := stkAPI.SQLDB.Model(&STKDeadLetter{}).Select("kind, COUNT(*) AS count").Group("kind").Scan(&counts).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to update dead letter metrics: %v", err)
+		return
+	}
+
+	deadLetterDepth.Reset()
+	for _, kind := range []stk.DeadLetterKind{stk.DeadLetterKind_DEAD_LETTER_PUBLISH, stk.DeadLetterKind_DEAD_LETTER_PROCESS_REQUEST} {
+		deadLetterDepth.WithLabelValues(kind.String()).Set(0)
+	}
+	for _, c := range counts {
+		deadLetterDepth.WithLabelValues(c.Kind).Set(float64(c.Count))
+	}
+}
+
+func (stkAPI *stkAPIServer) ListDeadLetters(
+	ctx context.Context, req *stk.ListDeadLettersRequest,
+) (*stk.ListDeadLettersResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	db := stkAPI.SQLDB.Model(&STKDeadLetter{})
+	if len(req.Kinds) > 0 {
+		db = db.Where("kind IN(?)", deadLetterKinds(req.Kinds))
+	}
+
+	res := &stk.ListDeadLettersResponse{}
+
+	err = db.Session(&gorm.Session{}).Count(&res.TotalCount).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to count dead letters")
+	}
+
+	db = db.Order("id DESC").Limit(int(pageSize) + 1)
+	if id != 0 {
+		db = db.Where("id < ?", id)
+	}
+
+	dbs := make([]*STKDeadLetter, 0, pageSize+1)
+
+	err = db.Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list dead letters")
+	}
+
+	if len(dbs) > int(pageSize) {
+		dbs = dbs[:pageSize]
+		res.NextPageToken = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(dbs[len(dbs)-1].ID)))
+	}
+
+	res.DeadLetters = make([]*stk.DeadLetter, 0, len(dbs))
+
+	for _, db := range dbs {
+		pb, err := DeadLetterToProto(db)
+		if err != nil {
+			return nil, err
+		}
+		res.DeadLetters = append(res.DeadLetters, pb)
+	}
+
+	return res, nil
+}
+
+func (stkAPI *stkAPIServer) RetryDeadLetters(
+	ctx context.Context, req *stk.RetryDeadLettersRequest,
+) (*stk.RetryDeadLettersResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("retry request")
+	case len(req.DeadLetterIds) == 0:
+		return nil, errs.MissingField("dead letter ids")
+	case len(req.DeadLetterIds) > maxRetryDeadLetters:
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "at most %d dead letters can be retried at once", maxRetryDeadLetters)
+	}
+
+	dbs := make([]*STKDeadLetter, 0, len(req.DeadLetterIds))
+
+	err = stkAPI.SQLDB.Order("id ASC").Find(&dbs, "id IN(?)", req.DeadLetterIds).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get dead letters")
+	}
+
+	found := make(map[uint64]*STKDeadLetter, len(dbs))
+	for _, db := range dbs {
+		found[uint64(db.ID)] = db
+	}
+
+	res := &stk.RetryDeadLettersResponse{
+		Retries: make([]*stk.DeadLetterRetry, 0, len(req.DeadLetterIds)),
+	}
+
+	for _, id := range req.DeadLetterIds {
+		retry := &stk.DeadLetterRetry{DeadLetterId: id}
+
+		db, ok := found[id]
+		if ok {
+			err = stkAPI.retryDeadLetter(ctx, db)
+		} else {
+			err = errors.New("dead letter not found")
+		}
+
+		if err != nil {
+			retry.Error = err.Error()
+			res.FailedCount++
+		} else {
+			retry.Succeeded = true
+			res.SucceededCount++
+		}
+
+		res.Retries = append(res.Retries, retry)
+	}
+
+	return res, nil
+}
+
+// retryDeadLetter handles the dead letter again, removing it on success
+func (stkAPI *stkAPIServer) retryDeadLetter(ctx context.Context, db *STKDeadLetter) error {
+	var errRetry error
+
+	switch db.Kind {
+	case stk.DeadLetterKind_DEAD_LETTER_PUBLISH.String():
+		errRetry = stkAPI.Publisher.Publish(ctx, db.Channel, db.Payload)
+	case stk.DeadLetterKind_DEAD_LETTER_PROCESS_REQUEST.String():
+		errRetry = stkAPI.handleProcessPayload(ctx, db.Payload, func(error) bool { return true })
+	default:
+		return fmt.Errorf("unknown dead letter kind %s", db.Kind)
+	}
+
+	if errRetry != nil {
+		err := stkAPI.SQLDB.Model(db).Updates(map[string]interface{}{
+			"attempts": gorm.Expr("attempts + 1"),
+			"error":    sql.NullString{String: truncate(errRetry.Error(), 300), Valid: true},
+		}).Error
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to update dead letter %d: %v", db.ID, err)
+		}
+		return errRetry
+	}
+
+	return stkAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		// The outbox entry the dead letter came from is now dispatched
+		if db.Kind == stk.DeadLetterKind_DEAD_LETTER_PUBLISH.String() && db.SourceID != "" {
+			err := tx.Model(&STKOutboxEntry{}).
+				Where("id = ? AND state = ?", db.SourceID, stk.OutboxEntryState_OUTBOX_DEAD_LETTERED.String()).
+				Updates(map[string]interface{}{
+					"state":         stk.OutboxEntryState_OUTBOX_DISPATCHED.String(),
+					"dispatched_at": sql.NullTime{Time: stkAPI.NowFunc().UTC(), Valid: true},
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Delete(db).Error
+	})
+}
+
+func (stkAPI *stkAPIServer) PurgeDeadLetters(
+	ctx context.Context, req *stk.PurgeDeadLettersRequest,
+) (*stk.PurgeDeadLettersResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("purge request")
+	case len(req.DeadLetterIds) == 0 && len(req.Kinds) == 0 && req.BeforeTimestamp <= 0:
+		return nil, errs.MissingField("dead letter ids, kinds or before timestamp")
+	}
+
+	db := stkAPI.SQLDB.Model(&STKDeadLetter{})
+	if len(req.DeadLetterIds) > 0 {
+		db = db.Where("id IN(?)", req.DeadLetterIds)
+	}
+	if len(req.Kinds) > 0 {
+		db = db.Where("kind IN(?)", deadLetterKinds(req.Kinds))
+	}
+	if req.BeforeTimestamp > 0 {
+		db = db.Where("created_at < ?", time.Unix(req.BeforeTimestamp, 0).UTC())
+	}
+
+	res := db.Delete(&STKDeadLetter{})
+	if res.Error != nil {
+		stkAPI.Logger.Errorln(res.Error)
+		return nil, errs.WrapMessage(codes.Internal, "failed to purge dead letters")
+	}
+
+	return &stk.PurgeDeadLettersResponse{PurgedCount: res.RowsAffected}, nil
+}
+
+func deadLetterKinds(kinds []stk.DeadLetterKind) []string {
+	ss := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		ss = append(ss, kind.String())
+	}
+	return ss
+}
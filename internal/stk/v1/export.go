@@ -0,0 +1,392 @@
+package stk
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/gidyon/mpesastk/pkg/xlsx"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	// exportBatchSize is the number of transactions read from the database at a time
+	exportBatchSize = 500
+	// exportChunkSize is the size of data sent in a single stream message
+	exportChunkSize = 64 * 1024
+)
+
+// exportColumn is a column of exported transactions
+type exportColumn struct {
+	name  string
+	value func(db *STKTransaction, loc *time.Location) interface{}
+}
+
+func exportTime(t time.Time, loc *time.Location) interface{} {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// exportColumns are the columns that can be exported, in their default order
+var exportColumns = []*exportColumn{
+	{"transaction_id", func(db *STKTransaction, _ *time.Location) interface{} { return uint64(db.ID) }},
+	{"initiator_id", func(db *STKTransaction, _ *time.Location) interface{} { return db.InitiatorID }},
+	{"initiator_customer_reference", func(db *STKTransaction, _ *time.Location) interface{} { return db.InitiatorCustomerReference }},
+	{"initiator_customer_names", func(db *STKTransaction, _ *time.Location) interface{} { return db.InitiatorCustomerNames }},
+	{"phone_number", func(db *STKTransaction, _ *time.Location) interface{} { return db.PhoneNumber }},
+	{"amount", func(db *STKTransaction, _ *time.Location) interface{} {
+		amount, err := strconv.ParseFloat(db.Amount, 64)
+		if err != nil {
+			return db.Amount
+		}
+		return amount
+	}},
+	{"short_code", func(db *STKTransaction, _ *time.Location) interface{} { return db.ShortCode }},
+	{"account_reference", func(db *STKTransaction, _ *time.Location) interface{} { return db.AccountReference }},
+	{"transaction_desc", func(db *STKTransaction, _ *time.Location) interface{} { return db.TransactionDesc.String }},
+	{"transaction_type", func(db *STKTransaction, _ *time.Location) interface{} { return db.TransactionType.String }},
+	{"merchant_request_id", func(db *STKTransaction, _ *time.Location) interface{} { return db.MerchantRequestID.String }},
+	{"checkout_request_id", func(db *STKTransaction, _ *time.Location) interface{} { return db.CheckoutRequestID.String }},
+	{"stk_response_code", func(db *STKTransaction, _ *time.Location) interface{} { return db.StkResponseCode.String }},
+	{"stk_response_description", func(db *STKTransaction, _ *time.Location) interface{} { return db.StkResponseDescription.String }},
+	{"stk_result_code", func(db *STKTransaction, _ *time.Location) interface{} { return db.ResultCode.String }},
+	{"stk_result_desc", func(db *STKTransaction, _ *time.Location) interface{} { return db.ResultDescription.String }},
+	{"mpesa_receipt_id", func(db *STKTransaction, _ *time.Location) interface{} { return db.MpesaReceiptId.String }},
+	{"balance", func(db *STKTransaction, _ *time.Location) interface{} { return db.Balance.String }},
+	{"status", func(db *STKTransaction, _ *time.Location) interface{} { return db.StkStatus.String }},
+	{"source", func(db *STKTransaction, _ *time.Location) interface{} { return db.Source.String }},
+	{"tag", func(db *STKTransaction, _ *time.Location) interface{} { return db.Tag.String }},
+	{"succeeded", func(db *STKTransaction, _ *time.Location) interface{} { return db.Succeeded }},
+	{"processed", func(db *STKTransaction, _ *time.Location) interface{} { return db.Processed }},
+	{"transaction_time", func(db *STKTransaction, loc *time.Location) interface{} {
+		return exportTime(db.TransactionTime.Time, loc)
+	}},
+	{"create_time", func(db *STKTransaction, loc *time.Location) interface{} { return exportTime(db.CreatedAt, loc) }},
+	{"update_time", func(db *STKTransaction, loc *time.Location) interface{} { return exportTime(db.UpdatedAt, loc) }},
+}
+
+// exportSpec describes the file transactions are exported to
+type exportSpec struct {
+	format  stk.ExportFormat
+	columns []*exportColumn
+	loc     *time.Location
+}
+
+// newExportSpec validates the format, columns and time zone of an export
+func newExportSpec(format stk.ExportFormat, columns []string, timeZone string) (*exportSpec, error) {
+	spec := &exportSpec{format: format, loc: time.UTC}
+
+	switch format {
+	case stk.ExportFormat_EXPORT_FORMAT_UNSPECIFIED:
+		spec.format = stk.ExportFormat_EXPORT_CSV
+	case stk.ExportFormat_EXPORT_CSV, stk.ExportFormat_EXPORT_NDJSON, stk.ExportFormat_EXPORT_XLSX:
+	default:
+		return nil, errs.IncorrectVal("export format")
+	}
+
+	if len(columns) == 0 {
+		spec.columns = exportColumns
+	}
+	for _, name := range columns {
+		var col *exportColumn
+		for _, c := range exportColumns {
+			if c.name == name {
+				col = c
+				break
+			}
+		}
+		if col == nil {
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "unknown export column %q", name)
+		}
+		spec.columns = append(spec.columns, col)
+	}
+
+	if timeZone != "" {
+		loc, err := time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, errs.IncorrectVal("time zone")
+		}
+		spec.loc = loc
+	}
+
+	return spec, nil
+}
+
+// contentType returns the media type and extension of the exported file
+func (spec *exportSpec) contentType() (string, string) {
+	switch spec.format {
+	case stk.ExportFormat_EXPORT_NDJSON:
+		return "application/x-ndjson", "ndjson"
+	case stk.ExportFormat_EXPORT_XLSX:
+		return xlsx.ContentType, "xlsx"
+	default:
+		return "text/csv", "csv"
+	}
+}
+
+// exportEncoder writes rows of exported transactions in a file format
+type exportEncoder interface {
+	WriteRow(values []interface{}) error
+	Close() error
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) WriteRow(values []interface{}) error {
+	record := make([]string, 0, len(values))
+	for _, v := range values {
+		record = append(record, fmt.Sprint(v))
+	}
+	return e.w.Write(record)
+}
+
+func (e *csvEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonEncoder struct {
+	w     *bufio.Writer
+	names []string
+}
+
+func (e *ndjsonEncoder) WriteRow(values []interface{}) error {
+	e.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			e.w.WriteByte(',')
+		}
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		e.w.WriteString(strconv.Quote(e.names[i]))
+		e.w.WriteByte(':')
+		e.w.Write(bs)
+	}
+	_, err := e.w.WriteString("}\n")
+	return err
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return e.w.Flush()
+}
+
+type xlsxEncoder struct {
+	w *xlsx.Writer
+}
+
+func (e *xlsxEncoder) WriteRow(values []interface{}) error {
+	return e.w.WriteRow(values...)
+}
+
+func (e *xlsxEncoder) Close() error {
+	return e.w.Close()
+}
+
+func (spec *exportSpec) newEncoder(w io.Writer) (exportEncoder, error) {
+	names := make([]string, 0, len(spec.columns))
+	header := make([]interface{}, 0, len(spec.columns))
+	for _, col := range spec.columns {
+		names = append(names, col.name)
+		header = append(header, col.name)
+	}
+
+	var enc exportEncoder
+
+	switch spec.format {
+	case stk.ExportFormat_EXPORT_NDJSON:
+		return &ndjsonEncoder{w: bufio.NewWriter(w), names: names}, nil
+	case stk.ExportFormat_EXPORT_XLSX:
+		xw, err := xlsx.NewWriter(w, "Transactions")
+		if err != nil {
+			return nil, err
+		}
+		enc = &xlsxEncoder{w: xw}
+	default:
+		enc = &csvEncoder{w: csv.NewWriter(w)}
+	}
+
+	err := enc.WriteRow(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc, nil
+}
+
+// exportQuery returns the transactions the actor may export
+func (stkAPI *stkAPIServer) exportQuery(ctx context.Context, actorID string, filter *stk.ListStkTransactionFilter) (*gorm.DB, error) {
+	// Read from redis list of phone numbers
+	allowedPhones, err := stkAPI.allowedPhones(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := stkAPI.SQLDB.Model(&STKTransaction{})
+
+	if len(allowedPhones) > 0 {
+		db = db.Where("phone_number IN(?)", allowedPhones)
+	}
+
+	return filterTransactions(db, filter)
+}
+
+// writeExport writes transactions matching the query to w, reading them in batches so that the
+// result set is never held in memory. It returns the number of transactions written.
+//
+// progress is called with the number of transactions written after each batch when not nil; the export
+// stops when it returns an error.
+func writeExport(
+	ctx context.Context, db *gorm.DB, filter *stk.ListStkTransactionFilter, spec *exportSpec, w io.Writer,
+	progress func(count int64) error,
+) (int64, error) {
+	enc, err := spec.newEncoder(w)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		count  int64
+		cursor *transactionsCursor
+		values = make([]interface{}, len(spec.columns))
+	)
+
+	for {
+		q, err := keysetPage(db.Session(&gorm.Session{}).WithContext(ctx), filter, cursor)
+		if err != nil {
+			return count, err
+		}
+
+		dbs := make([]*STKTransaction, 0, exportBatchSize)
+
+		err = q.Limit(exportBatchSize).Find(&dbs).Error
+		if err != nil {
+			return count, err
+		}
+
+		for _, db := range dbs {
+			for i, col := range spec.columns {
+				values[i] = col.value(db, spec.loc)
+			}
+			err = enc.WriteRow(values)
+			if err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if progress != nil {
+			err = progress(count)
+			if err != nil {
+				return count, err
+			}
+		}
+
+		if len(dbs) < exportBatchSize {
+			break
+		}
+
+		cursor = cursorAfter(dbs[len(dbs)-1], filter, "")
+	}
+
+	return count, enc.Close()
+}
+
+// exportChunkWriter sends data written to it over the export stream in chunks
+type exportChunkWriter struct {
+	stream      stk.StkPushV1_ExportStkTransactionsServer
+	buf         []byte
+	contentType string
+	fileName    string
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= exportChunkSize {
+		return len(p), w.Flush()
+	}
+	return len(p), nil
+}
+
+// Flush sends buffered data. The first chunk is always sent so that the client gets the file details.
+func (w *exportChunkWriter) Flush() error {
+	if len(w.buf) == 0 && w.contentType == "" {
+		return nil
+	}
+	err := w.stream.Send(&stk.ExportStkTransactionsChunk{
+		Data:        w.buf,
+		ContentType: w.contentType,
+		FileName:    w.fileName,
+	})
+	w.buf, w.contentType, w.fileName = make([]byte, 0, exportChunkSize), "", ""
+	return err
+}
+
+// exportFileName returns the name of an exported file created at t
+func exportFileName(t time.Time, ext string) string {
+	return fmt.Sprintf("stk-transactions-%s.%s", t.UTC().Format("20060102T150405Z"), ext)
+}
+
+func (stkAPI *stkAPIServer) ExportStkTransactions(
+	req *stk.ExportStkTransactionsRequest, stream stk.StkPushV1_ExportStkTransactionsServer,
+) error {
+	ctx := stream.Context()
+
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Validation
+	if req == nil {
+		return errs.MissingField("export request")
+	}
+
+	spec, err := newExportSpec(req.Format, req.Columns, req.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	db, err := stkAPI.exportQuery(ctx, actor.ID, req.Filter)
+	if err != nil {
+		return err
+	}
+
+	contentType, ext := spec.contentType()
+
+	w := &exportChunkWriter{
+		stream:      stream,
+		buf:         make([]byte, 0, exportChunkSize),
+		contentType: contentType,
+		fileName:    exportFileName(stkAPI.NowFunc(), ext),
+	}
+
+	_, err = writeExport(ctx, db, req.Filter, spec, w, nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return errs.WrapMessage(codes.Canceled, "export cancelled")
+		}
+		if errors.Is(err, xlsx.ErrTooManyRows) {
+			return errs.WrapMessage(codes.FailedPrecondition, "too many transactions for xlsx file, narrow the filter or use csv")
+		}
+		stkAPI.Logger.Errorf("failed to export stk transactions: %v", err)
+		return errs.WrapMessage(codes.Internal, "failed to export stk transactions")
+	}
+
+	return w.Flush()
+}
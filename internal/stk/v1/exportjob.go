@@ -0,0 +1,624 @@
+package stk
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultExportDir        = "stk-exports"
+	defaultExportURLExpiry  = time.Hour
+	defaultExportRetention  = 7 * 24 * time.Hour
+	exportSweepInterval     = time.Hour
+	exportJobLease          = 2 * time.Minute
+	exportJobWorkerInterval = 10 * time.Second
+	exportJobBatchSize      = 10
+	exportJobMaxAttempts    = 3
+)
+
+// STKExportJob is an export of stk transactions running in the background. The gzip compressed file is
+// saved in the export blob store.
+type STKExportJob struct {
+	ID           uint           `gorm:"primaryKey;autoIncrement"`
+	ActorID      string         `gorm:"index;type:varchar(50);not null"`
+	Request      []byte         `gorm:"type:blob;not null"`
+	State        string         `gorm:"index;type:varchar(30)"`
+	TotalRows    int64          `gorm:"type:bigint;not null;default:0"`
+	ExportedRows int64          `gorm:"type:bigint;not null;default:0"`
+	FileName     sql.NullString `gorm:"type:varchar(100)"`
+	ContentType  sql.NullString `gorm:"type:varchar(100)"`
+	BlobKey      sql.NullString `gorm:"type:varchar(200)"`
+	SizeBytes    int64          `gorm:"type:bigint;not null;default:0"`
+	Attempts     int32          `gorm:"type:int;not null;default:0"`
+	LockedUntil  sql.NullTime   `gorm:"type:datetime(6)"`
+	Error        sql.NullString `gorm:"type:varchar(300)"`
+	StartedAt    sql.NullTime   `gorm:"type:datetime(6)"`
+	CompletedAt  sql.NullTime   `gorm:"type:datetime(6)"`
+	UpdatedAt    time.Time      `gorm:"autoUpdateTime;type:datetime(6)"`
+	CreatedAt    time.Time      `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// ExportJobsTable is table for export jobs
+const ExportJobsTable = "stk_export_jobs"
+
+// TableName returns the name of the table
+func (*STKExportJob) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), ExportJobsTable)
+	}
+	return ExportJobsTable
+}
+
+// ExportJobToProto returns the protobuf message of export job
+func ExportJobToProto(db *STKExportJob) (*stk.ExportJob, error) {
+	if db == nil {
+		return nil, errs.MissingField("export job")
+	}
+
+	pb := &stk.ExportJob{
+		JobId:           uint64(db.ID),
+		ActorId:         db.ActorID,
+		State:           stk.ExportJobState(stk.ExportJobState_value[db.State]),
+		Export:          &stk.ExportStkTransactionsRequest{},
+		TotalRows:       db.TotalRows,
+		ExportedRows:    db.ExportedRows,
+		FileName:        db.FileName.String,
+		ContentType:     db.ContentType.String,
+		SizeBytes:       db.SizeBytes,
+		Error:           db.Error.String,
+		CreateTimestamp: db.CreatedAt.UTC().Unix(),
+	}
+
+	err := proto.Unmarshal(db.Request, pb.Export)
+	if err != nil {
+		return nil, errs.FromProtoUnMarshal(err, "export request")
+	}
+
+	switch {
+	case pb.State == stk.ExportJobState_EXPORT_JOB_COMPLETED:
+		pb.Progress = 1
+	case db.TotalRows > 0:
+		pb.Progress = float64(db.ExportedRows) / float64(db.TotalRows)
+		if pb.Progress > 1 {
+			pb.Progress = 1
+		}
+	}
+
+	if db.StartedAt.Valid {
+		pb.StartTimestamp = db.StartedAt.Time.UTC().Unix()
+	}
+	if db.CompletedAt.Valid {
+		pb.CompleteTimestamp = db.CompletedAt.Time.UTC().Unix()
+	}
+
+	return pb, nil
+}
+
+// exportJobProto returns the protobuf message of export job with a fresh download link
+func (stkAPI *stkAPIServer) exportJobProto(db *STKExportJob) (*stk.ExportJob, error) {
+	pb, err := ExportJobToProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	if pb.State == stk.ExportJobState_EXPORT_JOB_COMPLETED && stkAPI.ExportDownloadURL != "" {
+		expires := stkAPI.NowFunc().Add(stkAPI.exportURLExpiry())
+		pb.DownloadUrl = stkAPI.exportDownloadURL(db.ID, expires)
+		pb.DownloadUrlExpireTimestamp = expires.Unix()
+	}
+
+	return pb, nil
+}
+
+func (stkAPI *stkAPIServer) exportURLExpiry() time.Duration {
+	if stkAPI.ExportURLExpiry > 0 {
+		return stkAPI.ExportURLExpiry
+	}
+	return defaultExportURLExpiry
+}
+
+func (stkAPI *stkAPIServer) exportURLSignature(jobID uint, expires int64) string {
+	mac := hmac.New(sha256.New, stkAPI.exportURLKey)
+	fmt.Fprintf(mac, "%d:%d", jobID, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// exportDownloadURL returns the signed link for downloading the file of the export job until expires
+func (stkAPI *stkAPIServer) exportDownloadURL(jobID uint, expires time.Time) string {
+	query := url.Values{}
+	query.Set("job_id", fmt.Sprint(jobID))
+	query.Set("expires", fmt.Sprint(expires.Unix()))
+	query.Set("signature", stkAPI.exportURLSignature(jobID, expires.Unix()))
+	return stkAPI.ExportDownloadURL + "?" + query.Encode()
+}
+
+// ExportFile is the gzip compressed file of a completed export job
+type ExportFile struct {
+	FileName string
+	Size     int64
+	Body     io.ReadCloser
+}
+
+// OpenExportFile verifies the signed download link of an export job and opens its file
+func (stkAPI *stkAPIServer) OpenExportFile(ctx context.Context, jobID uint64, expires int64, signature string) (*ExportFile, error) {
+	switch {
+	case jobID == 0:
+		return nil, errs.MissingField("job id")
+	case !hmac.Equal([]byte(signature), []byte(stkAPI.exportURLSignature(uint(jobID), expires))):
+		return nil, errs.WrapMessage(codes.PermissionDenied, "incorrect download link signature")
+	case stkAPI.NowFunc().Unix() > expires:
+		return nil, errs.WrapMessage(codes.PermissionDenied, "download link has expired")
+	}
+
+	db := &STKExportJob{}
+	err := stkAPI.SQLDB.First(db, "id = ?", jobID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("export job", fmt.Sprint(jobID))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get export job")
+	}
+
+	switch db.State {
+	case stk.ExportJobState_EXPORT_JOB_COMPLETED.String():
+	case stk.ExportJobState_EXPORT_JOB_EXPIRED.String():
+		return nil, errs.WrapMessage(codes.NotFound, "export file was deleted after the retention period")
+	default:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "export job is not complete")
+	}
+
+	body, err := stkAPI.ExportStore.Open(ctx, db.BlobKey.String)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrBlobNotFound):
+		return nil, errs.DoesNotExist("export file", fmt.Sprint(jobID))
+	default:
+		stkAPI.Logger.Errorf("failed to open export file: %v", err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to open export file")
+	}
+
+	return &ExportFile{FileName: db.FileName.String, Size: db.SizeBytes, Body: body}, nil
+}
+
+func (stkAPI *stkAPIServer) wakeExportJobs() {
+	select {
+	case stkAPI.exportWake <- struct{}{}:
+	default:
+	}
+}
+
+// exportJobWorker runs pending export jobs and takes over jobs whose replica stopped renewing their lease.
+// It also deletes files of jobs that finished before the retention period.
+func (stkAPI *stkAPIServer) exportJobWorker(ctx context.Context) {
+	ticker := time.NewTicker(exportJobWorkerInterval)
+	defer ticker.Stop()
+
+	sweep := time.NewTicker(exportSweepInterval)
+	defer sweep.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sweep.C:
+			stkAPI.sweepExportFiles(ctx)
+			continue
+		case <-ticker.C:
+		case <-stkAPI.exportWake:
+		}
+
+		now := stkAPI.NowFunc().UTC()
+		ids := make([]uint, 0)
+
+		err := stkAPI.SQLDB.Model(&STKExportJob{}).Limit(exportJobBatchSize).Order("id ASC").
+			Where("state IN(?)", []string{stk.ExportJobState_EXPORT_JOB_PENDING.String(), stk.ExportJobState_EXPORT_JOB_RUNNING.String()}).
+			Where("locked_until IS NULL OR locked_until < ?", now).
+			Pluck("id", &ids).Error
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to get due export jobs: %v", err)
+			continue
+		}
+
+		for _, id := range ids {
+			stkAPI.runExportJob(ctx, id)
+		}
+	}
+}
+
+// sweepExportFiles deletes files of export jobs that completed before the retention period and marks the jobs expired
+func (stkAPI *stkAPIServer) sweepExportFiles(ctx context.Context) {
+	cutoff := stkAPI.NowFunc().UTC().Add(-stkAPI.ExportRetention)
+
+	dbs := make([]*STKExportJob, 0)
+
+	err := stkAPI.SQLDB.Select("id", "blob_key").Limit(defaultPageSize).Order("id ASC").
+		Where("state = ? AND completed_at < ?", stk.ExportJobState_EXPORT_JOB_COMPLETED.String(), cutoff).
+		Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to get expired export jobs: %v", err)
+		return
+	}
+
+	for _, db := range dbs {
+		if db.BlobKey.String != "" {
+			err = stkAPI.ExportStore.Delete(ctx, db.BlobKey.String)
+			if err != nil {
+				stkAPI.Logger.Errorf("failed to delete file of export job %d: %v", db.ID, err)
+				continue
+			}
+		}
+
+		err = stkAPI.SQLDB.Model(&STKExportJob{}).Where("id = ?", db.ID).Updates(map[string]interface{}{
+			"state":    stk.ExportJobState_EXPORT_JOB_EXPIRED.String(),
+			"blob_key": sql.NullString{},
+		}).Error
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to expire export job %d: %v", db.ID, err)
+		}
+	}
+}
+
+// countingWriter counts bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// runExportJob runs the export job if no other replica is running it
+func (stkAPI *stkAPIServer) runExportJob(ctx context.Context, id uint) {
+	now := stkAPI.NowFunc().UTC()
+
+	// Claim the job
+	res := stkAPI.SQLDB.Model(&STKExportJob{}).
+		Where("id = ? AND state IN(?)", id, []string{stk.ExportJobState_EXPORT_JOB_PENDING.String(), stk.ExportJobState_EXPORT_JOB_RUNNING.String()}).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Updates(map[string]interface{}{
+			"state":         stk.ExportJobState_EXPORT_JOB_RUNNING.String(),
+			"locked_until":  now.Add(exportJobLease),
+			"started_at":    now,
+			"attempts":      gorm.Expr("attempts + 1"),
+			"exported_rows": 0,
+		})
+	if res.Error != nil {
+		stkAPI.Logger.Errorf("failed to claim export job %d: %v", id, res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		return
+	}
+
+	db := &STKExportJob{}
+	err := stkAPI.SQLDB.First(db, "id = ?", id).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to get export job %d: %v", id, err)
+		return
+	}
+
+	updates, err := stkAPI.exportJobFile(ctx, db)
+	if err != nil {
+		stkAPI.Logger.Errorf("export job %d failed: %v", id, err)
+
+		updates = map[string]interface{}{
+			"state":        stk.ExportJobState_EXPORT_JOB_PENDING.String(),
+			"locked_until": sql.NullTime{},
+			"error":        sql.NullString{String: truncate(err.Error(), 300), Valid: true},
+		}
+		if db.Attempts >= exportJobMaxAttempts || status.Code(err) != codes.Internal {
+			updates["state"] = stk.ExportJobState_EXPORT_JOB_FAILED.String()
+			updates["completed_at"] = stkAPI.NowFunc().UTC()
+		}
+	}
+
+	err = stkAPI.SQLDB.Model(&STKExportJob{}).Where("id = ?", id).Updates(updates).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to update export job %d: %v", id, err)
+		return
+	}
+
+	if updates["state"] != stk.ExportJobState_EXPORT_JOB_PENDING.String() {
+		stkAPI.notifyExportJob(ctx, id)
+	}
+}
+
+// exportJobFile writes the gzip compressed file of the export job to the blob store. It returns updates
+// of the completed job.
+func (stkAPI *stkAPIServer) exportJobFile(ctx context.Context, db *STKExportJob) (map[string]interface{}, error) {
+	req := &stk.ExportStkTransactionsRequest{}
+	err := proto.Unmarshal(db.Request, req)
+	if err != nil {
+		return nil, errs.FromProtoUnMarshal(err, "export request")
+	}
+
+	spec, err := newExportSpec(req.Format, req.Columns, req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := stkAPI.exportQuery(ctx, db.ActorID, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	err = query.Session(&gorm.Session{}).Count(&total).Error
+	if err == nil {
+		err = stkAPI.SQLDB.Model(&STKExportJob{}).Where("id = ?", db.ID).Update("total_rows", total).Error
+	}
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to count transactions")
+	}
+
+	// Progress is saved after each batch together with a renewed lease
+	progress := func(count int64) error {
+		return stkAPI.SQLDB.Model(&STKExportJob{}).Where("id = ?", db.ID).Updates(map[string]interface{}{
+			"exported_rows": count,
+			"locked_until":  stkAPI.NowFunc().UTC().Add(exportJobLease),
+		}).Error
+	}
+
+	contentType, ext := spec.contentType()
+	fileName := exportFileName(db.CreatedAt, ext) + ".gz"
+	key := fmt.Sprintf("exports/%d/%s", db.ID, fileName)
+
+	var (
+		pr, pw = io.Pipe()
+		size   = &countingWriter{w: pw}
+		count  int64
+		done   = make(chan error, 1)
+	)
+
+	go func() {
+		gz := gzip.NewWriter(size)
+		n, err := writeExport(ctx, query, req.Filter, spec, gz, progress)
+		if err == nil {
+			err = gz.Close()
+		}
+		count = n
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	// Failing to save the file stops the export
+	err = stkAPI.ExportStore.Put(ctx, key, pr)
+	pr.CloseWithError(err)
+	errExport := <-done
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to save export file")
+	}
+	if errExport != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, errExport, "failed to export transactions")
+	}
+
+	return map[string]interface{}{
+		"state":         stk.ExportJobState_EXPORT_JOB_COMPLETED.String(),
+		"locked_until":  sql.NullTime{},
+		"exported_rows": count,
+		"file_name":     fileName,
+		"content_type":  contentType,
+		"blob_key":      key,
+		"size_bytes":    size.n,
+		"error":         sql.NullString{},
+		"completed_at":  stkAPI.NowFunc().UTC(),
+	}, nil
+}
+
+// notifyExportJob publishes the finished export job to the export jobs channel
+func (stkAPI *stkAPIServer) notifyExportJob(ctx context.Context, id uint) {
+	if stkAPI.ExportJobsChannel == "" {
+		return
+	}
+
+	db := &STKExportJob{}
+	err := stkAPI.SQLDB.First(db, "id = ?", id).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to get export job %d: %v", id, err)
+		return
+	}
+
+	pb, err := stkAPI.exportJobProto(db)
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to get export job %d: %v", id, err)
+		return
+	}
+
+	bs, err := protojson.Marshal(pb)
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to marshal export job: %v", err)
+		return
+	}
+
+	err = stkAPI.RedisDB.Publish(ctx, stkAPI.ExportJobsChannel, bs).Err()
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to publish export job %d: %v", id, err)
+	}
+}
+
+func (stkAPI *stkAPIServer) CreateExportJob(ctx context.Context, req *stk.CreateExportJobRequest) (*stk.ExportJob, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("create request")
+	case req.Export == nil:
+		return nil, errs.MissingField("export")
+	}
+
+	_, err = newExportSpec(req.Export.Format, req.Export.Columns, req.Export.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = filterTransactions(stkAPI.SQLDB.Model(&STKTransaction{}), req.Export.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	bs, err := proto.Marshal(req.Export)
+	if err != nil {
+		return nil, errs.FromProtoMarshal(err, "export request")
+	}
+
+	db := &STKExportJob{
+		ActorID: actor.ID,
+		Request: bs,
+		State:   stk.ExportJobState_EXPORT_JOB_PENDING.String(),
+	}
+
+	err = stkAPI.SQLDB.Create(db).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to create export job")
+	}
+
+	stkAPI.wakeExportJobs()
+
+	return stkAPI.exportJobProto(db)
+}
+
+func (stkAPI *stkAPIServer) GetExportJob(ctx context.Context, req *stk.GetExportJobRequest) (*stk.ExportJob, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("get request")
+	case req.JobId == 0:
+		return nil, errs.MissingField("job id")
+	}
+
+	db := &STKExportJob{}
+	err = stkAPI.SQLDB.First(db, "id = ?", req.JobId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("export job", fmt.Sprint(req.JobId))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get export job")
+	}
+
+	if db.ActorID != actor.ID && !stkAPI.AuthAPI.IsAdmin(actor.Group) {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "not allowed to view export job")
+	}
+
+	return stkAPI.exportJobProto(db)
+}
+
+func (stkAPI *stkAPIServer) ListExportJobs(ctx context.Context, req *stk.ListExportJobsRequest) (*stk.ListExportJobsResponse, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	db := stkAPI.SQLDB.Model(&STKExportJob{}).Order("id DESC").Limit(int(pageSize) + 1)
+	if id != 0 {
+		db = db.Where("id < ?", id)
+	}
+
+	// Admins see jobs of all actors
+	if !stkAPI.AuthAPI.IsAdmin(actor.Group) {
+		db = db.Where("actor_id = ?", actor.ID)
+	}
+
+	if len(req.States) > 0 {
+		ss := make([]string, 0, len(req.States))
+		for _, s := range req.States {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("state IN(?)", ss)
+	}
+
+	dbs := make([]*STKExportJob, 0, pageSize+1)
+
+	err = db.Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list export jobs")
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		dbs = dbs[:pageSize]
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(dbs[len(dbs)-1].ID)))
+	}
+
+	pbs := make([]*stk.ExportJob, 0, len(dbs))
+
+	for _, db := range dbs {
+		pb, err := stkAPI.exportJobProto(db)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+
+	return &stk.ListExportJobsResponse{
+		NextPageToken: token,
+		ExportJobs:    pbs,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package stk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// idempotencyPending is the value of an idempotency key whose transaction is not yet saved
+const idempotencyPending = "0"
+
+// GetIdempotencyKey is key that stores transaction id for an initiator idempotency key
+func GetIdempotencyKey(initiatorID, key string) string {
+	return fmt.Sprintf("stk:idempotency:%s:%s", initiatorID, key)
+}
+
+// claimIdempotencyKey claims the idempotency key in the request. It returns the response of the original
+// request when the key was used before. The original request must be for the same transaction as want.
+func (stkAPI *stkAPIServer) claimIdempotencyKey(
+	ctx context.Context, req *stk.InitiateSTKRequest, want *STKTransaction,
+) (*stk.InitiateSTKResponse, error) {
+	key := GetIdempotencyKey(req.InitiatorId, req.IdempotencyKey)
+
+	ok, err := stkAPI.RedisDB.SetNX(ctx, key, idempotencyPending, stkAPI.IdempotencyWindow).Result()
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to claim idempotency key")
+	}
+
+	if ok {
+		// The cache may have lost the key or the window expired, database is the fallback. Keys are
+		// unique in database beyond the idempotency window.
+		db := &STKTransaction{}
+		err = stkAPI.SQLDB.First(db, "initiator_id = ? AND idempotency_key = ?", req.InitiatorId, req.IdempotencyKey).Error
+		switch {
+		case err == nil:
+			stkAPI.setIdempotencyKey(ctx, req, db.ID)
+			return stkAPI.originalResponse(db, want)
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, nil
+		default:
+			stkAPI.releaseIdempotencyKey(ctx, req)
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+		}
+	}
+
+	val, err := stkAPI.RedisDB.Get(ctx, key).Result()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		// Key expired in between; treat as in progress to be safe
+		return nil, errs.WrapMessage(codes.Aborted, "request with similar idempotency key is in progress")
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get idempotency key")
+	}
+
+	if val == idempotencyPending {
+		return nil, errs.WrapMessage(codes.Aborted, "request with similar idempotency key is in progress")
+	}
+
+	id, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return nil, errs.WrapMessage(codes.Internal, "incorrect transaction id for idempotency key")
+	}
+
+	db := &STKTransaction{}
+	err = stkAPI.SQLDB.First(db, "id = ?", id).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+
+	return stkAPI.originalResponse(db, want)
+}
+
+// savedIdempotentRequest returns the response of the original request after saving a transaction failed
+// because its idempotency key is taken
+func (stkAPI *stkAPIServer) savedIdempotentRequest(
+	ctx context.Context, req *stk.InitiateSTKRequest, want *STKTransaction,
+) (*stk.InitiateSTKResponse, error) {
+	db := &STKTransaction{}
+	err := stkAPI.SQLDB.First(db, "initiator_id = ? AND idempotency_key = ?", req.InitiatorId, req.IdempotencyKey).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+
+	stkAPI.setIdempotencyKey(ctx, req, db.ID)
+
+	return stkAPI.originalResponse(db, want)
+}
+
+// isDuplicateKey reports whether the error is a unique constraint violation
+func isDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
+
+// mysqlDuplicateEntry is the mysql error number for a duplicate key
+const mysqlDuplicateEntry = 1062
+
+// setIdempotencyKey points the idempotency key to the saved transaction
+func (stkAPI *stkAPIServer) setIdempotencyKey(ctx context.Context, req *stk.InitiateSTKRequest, id uint) {
+	err := stkAPI.RedisDB.Set(ctx, GetIdempotencyKey(req.InitiatorId, req.IdempotencyKey), id, redis.KeepTTL).Err()
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to set idempotency key: %v", err)
+	}
+}
+
+// releaseIdempotencyKey frees the idempotency key so that the request can be retried
+func (stkAPI *stkAPIServer) releaseIdempotencyKey(ctx context.Context, req *stk.InitiateSTKRequest) {
+	err := stkAPI.RedisDB.Del(ctx, GetIdempotencyKey(req.InitiatorId, req.IdempotencyKey)).Err()
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to release idempotency key: %v", err)
+	}
+}
+
+// originalResponse returns the response of the original request while the idempotency window is open.
+// Keys stay taken after the window, so reusing them is rejected.
+func (stkAPI *stkAPIServer) originalResponse(db, want *STKTransaction) (*stk.InitiateSTKResponse, error) {
+	if stkAPI.NowFunc().Sub(db.CreatedAt) > stkAPI.IdempotencyWindow {
+		return nil, errs.WrapMessagef(codes.FailedPrecondition,
+			"idempotency key expired %v after it was first used; use a new key", stkAPI.IdempotencyWindow)
+	}
+	return duplicateResponse(db, want)
+}
+
+// duplicateResponse returns the response of the original request. Reusing an idempotency key for a
+// different transaction is rejected.
+func duplicateResponse(db, want *STKTransaction) (*stk.InitiateSTKResponse, error) {
+	amount, _ := strconv.ParseFloat(want.Amount, 32)
+
+	switch {
+	case !amountMatches(db.Amount, float32(amount)):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "idempotency key was used for a request with a different amount")
+	case db.PhoneNumber != want.PhoneNumber:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "idempotency key was used for a request with a different phone")
+	case db.ShortCode != want.ShortCode:
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "idempotency key was used for a request with a different short code")
+	}
+
+	pb, err := ToProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stk.InitiateSTKResponse{
+		Progress:       true,
+		Message:        "Duplicate request. Stk was already initiated",
+		TransactionId:  pb.TransactionId,
+		Duplicate:      true,
+		StkTransaction: pb,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package stk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDuplicateResponse(t *testing.T) {
+	saved := &STKTransaction{ID: 7, PhoneNumber: "254712345678", Amount: "150", ShortCode: "174379"}
+
+	tests := []struct {
+		name string
+		want *STKTransaction
+		code codes.Code
+	}{
+		{
+			name: "same request",
+			want: &STKTransaction{PhoneNumber: "254712345678", Amount: "150", ShortCode: "174379"},
+			code: codes.OK,
+		},
+		{
+			name: "different amount",
+			want: &STKTransaction{PhoneNumber: "254712345678", Amount: "200", ShortCode: "174379"},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "different phone",
+			want: &STKTransaction{PhoneNumber: "254700000000", Amount: "150", ShortCode: "174379"},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "different short code",
+			want: &STKTransaction{PhoneNumber: "254712345678", Amount: "150", ShortCode: "600000"},
+			code: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := duplicateResponse(saved, tt.want)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("duplicateResponse() code = %v, want %v (%v)", code, tt.code, err)
+			}
+			if err == nil && (!res.Duplicate || res.TransactionId != 7) {
+				t.Errorf("duplicateResponse() = %v, want duplicate of transaction 7", res)
+			}
+		})
+	}
+}
+
+func TestIsDuplicateKey(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, want: true},
+		{err: fmt.Errorf("create: %w", &mysql.MySQLError{Number: 1062}), want: true},
+		{err: &mysql.MySQLError{Number: 1213, Message: "Deadlock found"}, want: false},
+		{err: errors.New("Duplicate entry"), want: false},
+	}
+
+	for _, tt := range tests {
+		if got := isDuplicateKey(tt.err); got != tt.want {
+			t.Errorf("isDuplicateKey(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestOriginalResponseWindow(t *testing.T) {
+	now := time.Date(2024, 4, 6, 12, 0, 0, 0, time.UTC)
+	stkAPI := &stkAPIServer{Options: &Options{
+		NowFunc:           func() time.Time { return now },
+		IdempotencyWindow: 24 * time.Hour,
+	}}
+
+	want := &STKTransaction{PhoneNumber: "254712345678", Amount: "150", ShortCode: "174379"}
+
+	tests := []struct {
+		name    string
+		created time.Time
+		code    codes.Code
+	}{
+		{name: "within window", created: now.Add(-time.Hour), code: codes.OK},
+		{name: "at window end", created: now.Add(-24 * time.Hour), code: codes.OK},
+		{name: "after window", created: now.Add(-25 * time.Hour), code: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := &STKTransaction{ID: 7, PhoneNumber: "254712345678", Amount: "150", ShortCode: "174379", CreatedAt: tt.created}
+			_, err := stkAPI.originalResponse(saved, want)
+			if code := status.Code(err); code != tt.code {
+				t.Errorf("originalResponse() code = %v, want %v (%v)", code, tt.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package stk
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics of the stk service. They are registered in the default prometheus registry.
+var (
+	outboxPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "stk",
+		Subsystem: "outbox",
+		Name:      "pending_entries",
+		Help:      "Number of outbox entries not yet dispatched.",
+	})
+	outboxStuck = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "stk",
+		Subsystem: "outbox",
+		Name:      "stuck_entries",
+		Help:      "Number of outbox entries pending for longer than the stuck threshold.",
+	})
+	outboxOldestAge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "stk",
+		Subsystem: "outbox",
+		Name:      "oldest_pending_age_seconds",
+		Help:      "Age of the oldest outbox entry not yet dispatched.",
+	})
+	outboxDispatched = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "stk",
+		Subsystem: "outbox",
+		Name:      "dispatched_total",
+		Help:      "Number of outbox entries dispatched.",
+	})
+	outboxDispatchFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "stk",
+		Subsystem: "outbox",
+		Name:      "dispatch_failures_total",
+		Help:      "Number of failed attempts to dispatch outbox entries.",
+	})
+	deadLetterDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "stk",
+		Subsystem: "dlq",
+		Name:      "depth",
+		Help:      "Number of dead letters by kind.",
+	}, []string{"kind"})
+)
@@ -2,25 +2,27 @@ package stk
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/gidyon/gomicro/utils/errs"
 	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 // STKTransaction contains mpesa stk transaction details
 type STKTransaction struct {
 	ID                         uint           `gorm:"primaryKey;autoIncrement"`
-	InitiatorID                string         `gorm:"index;type:varchar(50)"`
+	InitiatorID                string         `gorm:"index;uniqueIndex:idx_initiator_idempotency_key;type:varchar(50)"`
 	InitiatorCustomerReference string         `gorm:"index;type:varchar(50)"`
-	InitiatorCustomerNames     string         `gorm:"type:varchar(50)"`
+	InitiatorCustomerNames     string         `gorm:"index:idx_initiator_customer_names;index:ft_initiator_customer_names,class:FULLTEXT;type:varchar(50)"`
 	PhoneNumber                string         `gorm:"index;type:varchar(15);not null"`
-	Amount                     string         `gorm:"type:float(10);not null"`
+	Amount                     string         `gorm:"index:idx_amount;type:float(10);not null"`
 	ShortCode                  string         `gorm:"index;type:varchar(15)"`
 	AccountReference           string         `gorm:"index;type:varchar(50)"`
-	TransactionDesc            sql.NullString `gorm:"type:varchar(300)"`
+	TransactionDesc            sql.NullString `gorm:"index:ft_transaction_desc,class:FULLTEXT;type:varchar(300)"`
 	MerchantRequestID          sql.NullString `gorm:"index;type:varchar(50);"`
 	CheckoutRequestID          sql.NullString `gorm:"index;type:varchar(50);"`
 	StkResponseDescription     sql.NullString `gorm:"type:varchar(300)"`
@@ -32,6 +34,11 @@ type STKTransaction struct {
 	StkStatus                  sql.NullString `gorm:"index;type:varchar(30)"`
 	Source                     sql.NullString `gorm:"index;type:varchar(30)"`
 	Tag                        sql.NullString `gorm:"index;type:varchar(30)"`
+	TransactionType            sql.NullString `gorm:"index;type:varchar(30)"`
+	IdempotencyKey             sql.NullString `gorm:"uniqueIndex:idx_initiator_idempotency_key;type:varchar(100)"`
+	Balance                    sql.NullString `gorm:"type:varchar(50)"`
+	CallbackExtras             sql.NullString `gorm:"type:text"`
+	CallbackTokenHash          sql.NullString `gorm:"type:varchar(64)"`
 	// Succeeded                  bool         `gorm:"index;type:tinyint(1)"`
 	// Processed                  bool         `gorm:"index;type:tinyint(1)"`
 	Succeeded       string       `gorm:"index;type:enum('YES','NO');default:NO"`
@@ -53,6 +60,40 @@ func (*STKTransaction) TableName() string {
 	return StkTable
 }
 
+// migrateColumns adds columns for fields added to the model after its table was created
+func migrateColumns(db *gorm.DB, model interface{}, fields ...string) error {
+	for _, field := range fields {
+		if !db.Migrator().HasColumn(model, field) {
+			err := db.Migrator().AddColumn(model, field)
+			if err != nil {
+				return fmt.Errorf("failed to add column for %s: %v", field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// dropNonUniqueIndex drops the index if it exists without a unique constraint so that it is created again as unique
+func dropNonUniqueIndex(db *gorm.DB, model interface{}, name string) error {
+	indexes, err := db.Migrator().GetIndexes(model)
+	if err != nil {
+		return fmt.Errorf("failed to get indexes: %v", err)
+	}
+	for _, index := range indexes {
+		if index.Name() != name {
+			continue
+		}
+		if unique, ok := index.Unique(); ok && !unique {
+			err = db.Migrator().DropIndex(model, name)
+			if err != nil {
+				return fmt.Errorf("failed to drop index %s: %v", name, err)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
 // ToProto returns the protobuf message of stk transaction
 func ToProto(db *STKTransaction) (*stk.StkTransaction, error) {
 	if db == nil {
@@ -76,7 +117,7 @@ func ToProto(db *STKTransaction) (*stk.StkTransaction, error) {
 		StkResultCode:              db.ResultCode.String,
 		StkResultDesc:              db.ResultDescription.String,
 		MpesaReceiptId:             db.MpesaReceiptId.String,
-		Balance:                    "",
+		Balance:                    db.Balance.String,
 		Status:                     stk.StkStatus(stk.StkStatus_value[db.StkStatus.String]),
 		Source:                     db.Source.String,
 		Tag:                        db.Tag.String,
@@ -84,6 +125,20 @@ func ToProto(db *STKTransaction) (*stk.StkTransaction, error) {
 		Processed:                  db.Processed == "YES",
 		TransactionTimestamp:       db.TransactionTime.Time.UTC().Unix(),
 		CreateTimestamp:            db.CreatedAt.UTC().Unix(),
+		UpdateTimestamp:            db.UpdatedAt.UTC().Unix(),
+		TransactionType:            stk.StkTransactionType(stk.StkTransactionType_value[db.TransactionType.String]),
+	}
+
+	if db.CallbackExtras.String != "" {
+		extras := make(map[string]interface{})
+		err := json.Unmarshal([]byte(db.CallbackExtras.String), &extras)
+		if err != nil {
+			return nil, errs.FromJSONUnMarshal(err, "callback extras")
+		}
+		pb.CallbackExtras = make(map[string]string, len(extras))
+		for k, v := range extras {
+			pb.CallbackExtras[k] = fmt.Sprint(v)
+		}
 	}
 
 	return pb, nil
@@ -0,0 +1,295 @@
+package stk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/gidyon/mpesapayments/pkg/utils/formatutil"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+)
+
+// TransactionUpdatesChannel is the redis channel where stk transaction events are broadcast across replicas
+const TransactionUpdatesChannel = "stk:transactions:updates"
+
+// NotifyTransactionUpdate broadcasts that the stk transaction was created or has changed to all replicas
+func NotifyTransactionUpdate(
+	ctx context.Context, redisDB *redis.Client, eventType stk.StkTransactionEventType, pb *stk.StkTransaction,
+) error {
+	bs, err := proto.Marshal(&stk.WatchStkTransactionsResponse{
+		EventType:      eventType,
+		StkTransaction: pb,
+		EventTimestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	return redisDB.Publish(ctx, TransactionUpdatesChannel, bs).Err()
+}
+
+const watcherBufferSize = 100
+
+// updatesHub dispatches transaction events to local waiters and watchers
+type updatesHub struct {
+	mu       sync.Mutex
+	waiters  map[uint64]map[chan struct{}]struct{}
+	watchers map[chan *stk.WatchStkTransactionsResponse]struct{}
+}
+
+func newUpdatesHub() *updatesHub {
+	return &updatesHub{
+		waiters:  make(map[uint64]map[chan struct{}]struct{}),
+		watchers: make(map[chan *stk.WatchStkTransactionsResponse]struct{}),
+	}
+}
+
+// subscribe returns a channel that receives when the transaction is updated and a function to unsubscribe
+func (h *updatesHub) subscribe(transactionID uint64) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	if h.waiters[transactionID] == nil {
+		h.waiters[transactionID] = make(map[chan struct{}]struct{})
+	}
+	h.waiters[transactionID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.waiters[transactionID], ch)
+		if len(h.waiters[transactionID]) == 0 {
+			delete(h.waiters, transactionID)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// watch returns a channel that receives all transaction events and a function to stop watching
+func (h *updatesHub) watch() (<-chan *stk.WatchStkTransactionsResponse, func()) {
+	ch := make(chan *stk.WatchStkTransactionsResponse, watcherBufferSize)
+
+	h.mu.Lock()
+	h.watchers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.watchers, ch)
+		h.mu.Unlock()
+	}
+}
+
+// notify dispatches the event. It returns number of watchers that were too slow to receive it.
+func (h *updatesHub) notify(event *stk.WatchStkTransactionsResponse) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.waiters[event.GetStkTransaction().GetTransactionId()] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+
+	dropped := 0
+	for ch := range h.watchers {
+		select {
+		case ch <- event:
+		default:
+			dropped++
+		}
+	}
+
+	return dropped
+}
+
+// notifyUpdate broadcasts the latest state of the transaction logging any failure
+func (stkAPI *stkAPIServer) notifyUpdate(ctx context.Context, eventType stk.StkTransactionEventType, transactionID uint) {
+	err := func() error {
+		db := &STKTransaction{}
+		err := stkAPI.SQLDB.First(db, "id=?", transactionID).Error
+		if err != nil {
+			return err
+		}
+
+		pb, err := ToProto(db)
+		if err != nil {
+			return err
+		}
+
+		return NotifyTransactionUpdate(ctx, stkAPI.RedisDB, eventType, pb)
+	}()
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to notify stk transaction update: %v", err)
+	}
+}
+
+func (stkAPI *stkAPIServer) transactionUpdatesWorker(ctx context.Context) {
+	for {
+		pubsub := stkAPI.RedisDB.Subscribe(ctx, TransactionUpdatesChannel)
+		ch := pubsub.Channel()
+
+	loop:
+		for {
+			select {
+			case <-ctx.Done():
+				pubsub.Close()
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					break loop
+				}
+				event := &stk.WatchStkTransactionsResponse{}
+				err := proto.Unmarshal([]byte(msg.Payload), event)
+				if err != nil {
+					stkAPI.Logger.Warningf("failed to unmarshal stk transaction event: %v", err)
+					continue
+				}
+				if dropped := stkAPI.updates.notify(event); dropped > 0 {
+					stkAPI.Logger.Warningf("stk transaction event dropped for %d slow watchers", dropped)
+				}
+			}
+		}
+
+		pubsub.Close()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+// matchesFilter checks whether the transaction satisfies the list filter and phone restrictions
+func matchesFilter(pb *stk.StkTransaction, filter *stk.ListStkTransactionFilter, allowedPhones []string) bool {
+	if pb == nil {
+		return false
+	}
+
+	if len(allowedPhones) > 0 && !containsString(allowedPhones, pb.PhoneNumber) {
+		return false
+	}
+
+	if filter == nil {
+		return true
+	}
+
+	switch {
+	case len(filter.Msisdns) > 0 && !containsString(filter.Msisdns, pb.PhoneNumber):
+		return false
+	case len(filter.MpesaReceipts) > 0 && !containsString(filter.MpesaReceipts, pb.MpesaReceiptId):
+		return false
+	case len(filter.InitiatorCustomerReferences) > 0 && !containsString(filter.InitiatorCustomerReferences, pb.InitiatorCustomerReference):
+		return false
+	case len(filter.ShortCodes) > 0 && !containsString(filter.ShortCodes, pb.ShortCode):
+		return false
+	case len(filter.InitiatorIds) > 0 && !containsString(filter.InitiatorIds, pb.InitiatorId):
+		return false
+	case len(filter.AccountReferences) > 0 && !containsString(filter.AccountReferences, pb.AccountReference):
+		return false
+	case filter.SucceededState == stk.StkSucceededState_STK_SUCCEEDED && !pb.Succeeded:
+		return false
+	case filter.SucceededState == stk.StkSucceededState_STK_NOT_SUCCEEDED && pb.Succeeded:
+		return false
+	case filter.CustomerNames != "" && !matchesWords(pb.InitiatorCustomerNames, filter.CustomerNames):
+		return false
+	case filter.TransactionDesc != "" && !matchesWords(pb.TransactionDesc, filter.TransactionDesc):
+		return false
+	case filter.Query != "" && !matchesQuery(pb, filter.Query):
+		return false
+	}
+
+	if filter.MinAmount > 0 || filter.MaxAmount > 0 {
+		amount, err := strconv.ParseFloat(pb.Amount, 64)
+		switch {
+		case err != nil:
+			return false
+		case filter.MinAmount > 0 && amount < filter.MinAmount:
+			return false
+		case filter.MaxAmount > 0 && amount > filter.MaxAmount:
+			return false
+		}
+	}
+
+	if len(filter.StkStatuses) > 0 {
+		found := false
+		for _, s := range filter.StkStatuses {
+			if s == pb.Status {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(filter.TransactionTypes) > 0 {
+		found := false
+		for _, t := range filter.TransactionTypes {
+			if t == pb.TransactionType {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	switch filter.ProcessState {
+	case stk.StkProcessedState_STK_PROCESSED:
+		return pb.Processed
+	case stk.StkProcessedState_STK_NOT_PROCESSED:
+		return !pb.Processed
+	}
+
+	return true
+}
+
+// matchesWords reports whether s starts with search or every word of search starts a word in s, case insensitively
+func matchesWords(s, search string) bool {
+	s, search = strings.ToLower(s), strings.ToLower(strings.TrimSpace(search))
+	if strings.HasPrefix(s, search) {
+		return true
+	}
+	isSep := func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }
+	words := strings.FieldsFunc(s, isSep)
+	for _, term := range strings.FieldsFunc(search, isSep) {
+		found := false
+		for _, word := range words {
+			if strings.HasPrefix(word, term) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesQuery applies the free-text query of a transactions filter
+func matchesQuery(pb *stk.StkTransaction, query string) bool {
+	query = strings.TrimSpace(query)
+	return strings.HasPrefix(pb.MpesaReceiptId, query) ||
+		strings.HasPrefix(pb.PhoneNumber, formatutil.FormatPhoneKE(query)) ||
+		strings.HasPrefix(pb.AccountReference, query) ||
+		matchesWords(pb.InitiatorCustomerNames, query)
+}
+
+func containsString(vals []string, v string) bool {
+	for _, val := range vals {
+		if val == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,427 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultOutboxStuckAfter = 5 * time.Minute
+	outboxBaseBackoff       = time.Second
+	outboxMaxBackoff        = 5 * time.Minute
+	outboxLease             = time.Minute
+	outboxWorkerInterval    = 5 * time.Second
+	outboxBatchSize         = 100
+)
+
+// STKOutboxEntry is a publish message saved in the same database transaction as the stk transaction update.
+// Entries are dispatched at least once; they stay pending until the channel accepts them.
+type STKOutboxEntry struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	TransactionID uint           `gorm:"index;not null"`
+	Channel       string         `gorm:"type:varchar(100);not null"`
+	Payload       []byte         `gorm:"type:blob;not null"`
+	State         string         `gorm:"index:idx_state_next_attempt;type:varchar(30)"`
+	Attempts      int32          `gorm:"type:int;not null;default:0"`
+	NextAttemptAt sql.NullTime   `gorm:"index:idx_state_next_attempt;type:datetime(6)"`
+	LockedUntil   sql.NullTime   `gorm:"type:datetime(6)"`
+	LastError     sql.NullString `gorm:"type:varchar(300)"`
+	DispatchedAt  sql.NullTime   `gorm:"type:datetime(6)"`
+	CreatedAt     time.Time      `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// OutboxTable is table for stk outbox entries
+const OutboxTable = "stk_outbox"
+
+// TableName returns the name of the table
+func (*STKOutboxEntry) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), OutboxTable)
+	}
+	return OutboxTable
+}
+
+// OutboxEntryToProto returns the protobuf message of outbox entry
+func OutboxEntryToProto(db *STKOutboxEntry, stuckBefore time.Time) (*stk.OutboxEntry, error) {
+	if db == nil {
+		return nil, errs.MissingField("outbox entry")
+	}
+
+	pb := &stk.OutboxEntry{
+		EntryId:         uint64(db.ID),
+		TransactionId:   uint64(db.TransactionID),
+		Channel:         db.Channel,
+		State:           stk.OutboxEntryState(stk.OutboxEntryState_value[db.State]),
+		Attempts:        db.Attempts,
+		LastError:       db.LastError.String,
+		CreateTimestamp: db.CreatedAt.UTC().Unix(),
+	}
+
+	if db.NextAttemptAt.Valid {
+		pb.NextAttemptTimestamp = db.NextAttemptAt.Time.UTC().Unix()
+	}
+	if db.DispatchedAt.Valid {
+		pb.DispatchTimestamp = db.DispatchedAt.Time.UTC().Unix()
+	}
+
+	pb.Stuck = pb.State == stk.OutboxEntryState_OUTBOX_PENDING && db.CreatedAt.Before(stuckBefore)
+
+	return pb, nil
+}
+
+func outboxBackoff(attempts int32) time.Duration {
+	backoff := outboxBaseBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= outboxMaxBackoff {
+			return outboxMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// publishMessage returns the message published for the transaction or nil when nothing is published.
+// Transactions are published when the initiate request asked for it or the initiator has a default webhook.
+func (stkAPI *stkAPIServer) publishMessage(initReq *stk.InitiateSTKRequest, pb *stk.StkTransaction) (*stk.PublishMessage, error) {
+	if initReq.GetPublish() {
+		if !pb.Succeeded && initReq.GetPublishMessage().GetOnlyOnSuccess() {
+			return nil, nil
+		}
+	} else {
+		// Initiators with a default webhook are notified of all their transactions
+		hook, err := stkAPI.initiatorWebhook(pb.InitiatorId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get initiator webhook: %v", err)
+		}
+		if hook == nil {
+			return nil, nil
+		}
+	}
+
+	return &stk.PublishMessage{
+		InitiatorId:     pb.InitiatorId,
+		TransactionId:   pb.TransactionId,
+		MpesaReceiptId:  pb.MpesaReceiptId,
+		PhoneNumber:     pb.PhoneNumber,
+		PublishInfo:     initReq.GetPublishMessage(),
+		TransactionInfo: pb,
+	}, nil
+}
+
+// outboxWriter saves publish messages of a transaction update in the database transaction of the update
+type outboxWriter struct {
+	stkAPI     *stkAPIServer
+	initReq    *stk.InitiateSTKRequest
+	entry      bool
+	deliveries []uint
+}
+
+func (stkAPI *stkAPIServer) newOutboxWriter(initReq *stk.InitiateSTKRequest) *outboxWriter {
+	return &outboxWriter{stkAPI: stkAPI, initReq: initReq}
+}
+
+// hook is the TxHook that writes the outbox entry and webhook delivery
+func (w *outboxWriter) hook(tx *gorm.DB, db *STKTransaction) error {
+	w.entry, w.deliveries = false, nil
+
+	pb, err := ToProto(db)
+	if err != nil {
+		return err
+	}
+
+	msg, err := w.stkAPI.publishMessage(w.initReq, pb)
+	if err != nil || msg == nil {
+		return err
+	}
+
+	if channel := msg.GetPublishInfo().GetChannelName(); channel != "" {
+		bs, err := w.stkAPI.encodeMessage(msg)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(&STKOutboxEntry{
+			TransactionID: db.ID,
+			Channel:       channel,
+			Payload:       bs,
+			State:         stk.OutboxEntryState_OUTBOX_PENDING.String(),
+			NextAttemptAt: sql.NullTime{Time: w.stkAPI.NowFunc().UTC(), Valid: true},
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to save outbox entry: %v", err)
+		}
+		w.entry = true
+	}
+
+	delivery, err := w.stkAPI.webhookDelivery(msg)
+	if err != nil || delivery == nil {
+		return err
+	}
+
+	err = tx.Create(delivery).Error
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery: %v", err)
+	}
+	w.deliveries = append(w.deliveries, delivery.ID)
+
+	return nil
+}
+
+// flush starts dispatching what the committed update wrote
+func (w *outboxWriter) flush() {
+	if w.entry {
+		w.stkAPI.wakeOutbox()
+	}
+	for _, id := range w.deliveries {
+		go w.stkAPI.deliverWebhook(context.Background(), id)
+	}
+}
+
+func (stkAPI *stkAPIServer) wakeOutbox() {
+	select {
+	case stkAPI.outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+func (stkAPI *stkAPIServer) outboxStuckAfter() time.Duration {
+	if stkAPI.OutboxStuckAfter > 0 {
+		return stkAPI.OutboxStuckAfter
+	}
+	return defaultOutboxStuckAfter
+}
+
+func (stkAPI *stkAPIServer) outboxWorker(ctx context.Context) {
+	ticker := time.NewTicker(outboxWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stkAPI.updateOutboxMetrics()
+			stkAPI.updateDeadLetterMetrics()
+		case <-stkAPI.outboxWake:
+		}
+
+		ids := make([]uint, 0)
+
+		err := stkAPI.SQLDB.Model(&STKOutboxEntry{}).Limit(outboxBatchSize).Order("id ASC").
+			Where("state = ? AND next_attempt_at <= ?", stk.OutboxEntryState_OUTBOX_PENDING.String(), stkAPI.NowFunc().UTC()).
+			Where("locked_until IS NULL OR locked_until < ?", stkAPI.NowFunc().UTC()).
+			Pluck("id", &ids).Error
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to get due outbox entries: %v", err)
+			continue
+		}
+
+		// Entries are dispatched in order of creation
+		for _, id := range ids {
+			stkAPI.dispatchOutboxEntry(ctx, id)
+		}
+	}
+}
+
+// dispatchOutboxEntry publishes the entry if no other replica is dispatching it
+func (stkAPI *stkAPIServer) dispatchOutboxEntry(ctx context.Context, id uint) {
+	now := stkAPI.NowFunc().UTC()
+
+	// Claim the entry
+	res := stkAPI.SQLDB.Model(&STKOutboxEntry{}).
+		Where("id = ? AND state = ?", id, stk.OutboxEntryState_OUTBOX_PENDING.String()).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Update("locked_until", now.Add(outboxLease))
+	if res.Error != nil {
+		stkAPI.Logger.Errorf("failed to claim outbox entry %d: %v", id, res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		return
+	}
+
+	db := &STKOutboxEntry{}
+	err := stkAPI.SQLDB.First(db, "id = ?", id).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to get outbox entry %d: %v", id, err)
+		return
+	}
+
+	updates := map[string]interface{}{
+		"attempts":     db.Attempts + 1,
+		"locked_until": sql.NullTime{},
+	}
+
+	errPublish := stkAPI.Publisher.Publish(ctx, db.Channel, db.Payload)
+	if errPublish == nil {
+		outboxDispatched.Inc()
+		updates["state"] = stk.OutboxEntryState_OUTBOX_DISPATCHED.String()
+		updates["dispatched_at"] = sql.NullTime{Time: stkAPI.NowFunc().UTC(), Valid: true}
+		updates["next_attempt_at"] = sql.NullTime{}
+		updates["last_error"] = sql.NullString{}
+	} else {
+		outboxDispatchFailures.Inc()
+		stkAPI.Logger.Warningf("failed to dispatch outbox entry %d: %v", db.ID, errPublish)
+		updates["next_attempt_at"] = sql.NullTime{Time: stkAPI.NowFunc().UTC().Add(outboxBackoff(db.Attempts + 1)), Valid: true}
+		updates["last_error"] = sql.NullString{String: truncate(errPublish.Error(), 300), Valid: true}
+	}
+
+	deadLetter := errPublish != nil && db.Attempts+1 >= stkAPI.outboxMaxAttempts()
+	if deadLetter {
+		updates["state"] = stk.OutboxEntryState_OUTBOX_DEAD_LETTERED.String()
+		updates["next_attempt_at"] = sql.NullTime{}
+	}
+
+	// A failure here means the entry is dispatched again once the lease expires
+	err = stkAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		if deadLetter {
+			err := stkAPI.saveDeadLetter(tx, &STKDeadLetter{
+				Kind:          stk.DeadLetterKind_DEAD_LETTER_PUBLISH.String(),
+				Channel:       db.Channel,
+				Payload:       db.Payload,
+				Attempts:      db.Attempts + 1,
+				TransactionID: sql.NullInt64{Int64: int64(db.TransactionID), Valid: true},
+				SourceID:      fmt.Sprint(db.ID),
+			}, errPublish)
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(db).Updates(updates).Error
+	})
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to update outbox entry %d: %v", db.ID, err)
+	}
+}
+
+func (stkAPI *stkAPIServer) updateOutboxMetrics() {
+	var (
+		pending     = stk.OutboxEntryState_OUTBOX_PENDING.String()
+		stuckBefore = stkAPI.NowFunc().UTC().Add(-stkAPI.outboxStuckAfter())
+		pendingN    int64
+		stuckN      int64
+		oldest      sql.NullTime
+	)
+
+	err := stkAPI.SQLDB.Model(&STKOutboxEntry{}).Where("state = ?", pending).Count(&pendingN).Error
+	if err == nil {
+		err = stkAPI.SQLDB.Model(&STKOutboxEntry{}).Where("state = ? AND created_at < ?", pending, stuckBefore).Count(&stuckN).Error
+	}
+	if err == nil {
+		err = stkAPI.SQLDB.Model(&STKOutboxEntry{}).Where("state = ?", pending).Select("MIN(created_at)").Scan(&oldest).Error
+	}
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to update outbox metrics: %v", err)
+		return
+	}
+
+	outboxPending.Set(float64(pendingN))
+	outboxStuck.Set(float64(stuckN))
+	if oldest.Valid {
+		outboxOldestAge.Set(stkAPI.NowFunc().Sub(oldest.Time).Seconds())
+	} else {
+		outboxOldestAge.Set(0)
+	}
+}
+
+func (stkAPI *stkAPIServer) ListOutboxEntries(
+	ctx context.Context, req *stk.ListOutboxEntriesRequest,
+) (*stk.ListOutboxEntriesResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	var (
+		pending     = stk.OutboxEntryState_OUTBOX_PENDING.String()
+		stuckBefore = stkAPI.NowFunc().UTC().Add(-stkAPI.outboxStuckAfter())
+		res         = &stk.ListOutboxEntriesResponse{}
+	)
+
+	err = stkAPI.SQLDB.Model(&STKOutboxEntry{}).Where("state = ?", pending).Count(&res.PendingCount).Error
+	if err == nil {
+		err = stkAPI.SQLDB.Model(&STKOutboxEntry{}).Where("state = ? AND created_at < ?", pending, stuckBefore).Count(&res.StuckCount).Error
+	}
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to count outbox entries")
+	}
+
+	db := stkAPI.SQLDB.Model(&STKOutboxEntry{}).Order("id DESC").Limit(int(pageSize) + 1)
+	if id != 0 {
+		db = db.Where("id < ?", id)
+	}
+	if len(req.States) > 0 {
+		ss := make([]string, 0, len(req.States))
+		for _, s := range req.States {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("state IN(?)", ss)
+	}
+	if req.StuckOnly {
+		db = db.Where("state = ? AND created_at < ?", pending, stuckBefore)
+	}
+
+	dbs := make([]*STKOutboxEntry, 0, pageSize+1)
+
+	err = db.Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list outbox entries")
+	}
+
+	if len(dbs) > int(pageSize) {
+		dbs = dbs[:pageSize]
+		res.NextPageToken = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(dbs[len(dbs)-1].ID)))
+	}
+
+	res.Entries = make([]*stk.OutboxEntry, 0, len(dbs))
+
+	for _, db := range dbs {
+		pb, err := OutboxEntryToProto(db, stuckBefore)
+		if err != nil {
+			return nil, err
+		}
+		res.Entries = append(res.Entries, pb)
+	}
+
+	return res, nil
+}
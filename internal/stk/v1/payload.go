@@ -1,5 +1,7 @@
 package stk
 
+import stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+
 // STKRequestBody is STK push request payload
 type STKRequestBody struct {
 	BusinessShortCode string `json:"BusinessShortCode,omitempty"`
@@ -14,3 +16,31 @@ type STKRequestBody struct {
 	AccountReference  string `json:"AccountReference,omitempty"`
 	TransactionDesc   string `json:"TransactionDesc,omitempty"`
 }
+
+const (
+	// CustomerPayBillOnline is transaction type for paybill stk push
+	CustomerPayBillOnline = "CustomerPayBillOnline"
+	// CustomerBuyGoodsOnline is transaction type for till (buy goods) stk push
+	CustomerBuyGoodsOnline = "CustomerBuyGoodsOnline"
+)
+
+// ParseTransactionType parses a daraja transaction type or its enum name into the enum value
+func ParseTransactionType(v string) stk.StkTransactionType {
+	switch v {
+	case CustomerPayBillOnline, stk.StkTransactionType_CUSTOMER_PAYBILL_ONLINE.String():
+		return stk.StkTransactionType_CUSTOMER_PAYBILL_ONLINE
+	case CustomerBuyGoodsOnline, stk.StkTransactionType_CUSTOMER_BUY_GOODS_ONLINE.String():
+		return stk.StkTransactionType_CUSTOMER_BUY_GOODS_ONLINE
+	}
+	return stk.StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+// DarajaTransactionType returns the transaction type value expected by daraja API
+func DarajaTransactionType(t stk.StkTransactionType) string {
+	switch t {
+	case stk.StkTransactionType_CUSTOMER_BUY_GOODS_ONLINE:
+		return CustomerBuyGoodsOnline
+	default:
+		return CustomerPayBillOnline
+	}
+}
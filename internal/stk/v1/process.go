@@ -0,0 +1,224 @@
+package stk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// ProcessRequestVersion is the latest supported version of process request messages
+const ProcessRequestVersion = 1
+
+// Formats of process request messages
+const (
+	processFormatJSON   = "json"
+	processFormatProto  = "proto"
+	processFormatLegacy = "legacy"
+)
+
+// processRequest is a decoded process request and the format it came in
+type processRequest struct {
+	msg    *stk.StkProcessRequest
+	format string
+}
+
+// decodeProcessRequest decodes a json or protobuf process request. The legacy "<receipt>/<true|yes>"
+// payload is still understood.
+func decodeProcessRequest(payload []byte) (*processRequest, error) {
+	var (
+		msg     = &stk.StkProcessRequest{}
+		format  string
+		trimmed = bytes.TrimSpace(payload)
+	)
+
+	switch {
+	case len(trimmed) == 0:
+		return nil, errs.MissingField("process request")
+	case trimmed[0] == '{':
+		err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(trimmed, msg)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to decode process request")
+		}
+		format = processFormatJSON
+	case isLegacyProcessRequest(trimmed):
+		datas := strings.Split(string(trimmed), "/")
+		processed := strings.ToLower(datas[1])
+		msg = &stk.StkProcessRequest{
+			Version:        ProcessRequestVersion,
+			MpesaReceiptId: datas[0],
+			Processed:      processed == "true" || processed == "yes",
+		}
+		format = processFormatLegacy
+	default:
+		err := proto.Unmarshal(payload, msg)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to decode process request")
+		}
+		format = processFormatProto
+	}
+
+	switch {
+	case msg.Version < 0 || msg.Version > ProcessRequestVersion:
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "unsupported process request version %d", msg.Version)
+	case msg.TransactionId == 0 && msg.MpesaReceiptId == "":
+		return nil, errs.MissingField("transaction/mpesa id")
+	}
+
+	return &processRequest{msg: msg, format: format}, nil
+}
+
+func isLegacyProcessRequest(payload []byte) bool {
+	if !utf8.Valid(payload) || bytes.Count(payload, []byte("/")) != 1 {
+		return false
+	}
+	for _, r := range string(payload) {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// processParams are parameters for changing processed state of a stk transaction
+type processParams struct {
+	TransactionID  uint64
+	MpesaReceiptID string
+	Processed      bool
+	Source         stk.StkEventSource
+	ActorID        string
+	Note           string
+}
+
+// processTransaction sets processed state of the transaction and records the change in its history.
+// Callers are responsible for authorization.
+func (stkAPI *stkAPIServer) processTransaction(ctx context.Context, p *processParams) (*STKTransaction, error) {
+	processed := "NO"
+	if p.Processed {
+		processed = "YES"
+	}
+
+	var (
+		db  = &STKTransaction{}
+		err error
+	)
+
+	if p.TransactionID != 0 {
+		err = stkAPI.SQLDB.Unscoped().First(db, "id=?", p.TransactionID).Error
+	} else {
+		err = stkAPI.SQLDB.Unscoped().First(db, "mpesa_receipt_id=?", p.MpesaReceiptID).Error
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("stk transaction", firstVal(p.MpesaReceiptID, fmt.Sprint(p.TransactionID)))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to process stk transaction")
+	}
+
+	description := fmt.Sprintf("processed set to %s", processed)
+	if p.Note != "" {
+		description = fmt.Sprintf("%s: %s", description, p.Note)
+	}
+
+	err = stkAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&STKTransaction{}).Unscoped().Where("id=?", db.ID).Update("processed", processed).Error
+		if err != nil {
+			return err
+		}
+		return RecordEvent(tx, db, p.Source, description, p.ActorID)
+	})
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to process stk transaction")
+	}
+
+	db.Processed = processed
+
+	stkAPI.notifyUpdate(ctx, stk.StkTransactionEventType_STK_TRANSACTION_UPDATED, db.ID)
+
+	return db, nil
+}
+
+// handleProcessPayload applies the process request in payload. The request is acknowledged when it succeeds
+// or when final reports that its failure will not be retried.
+func (stkAPI *stkAPIServer) handleProcessPayload(ctx context.Context, payload []byte, final func(error) bool) error {
+	req, err := decodeProcessRequest(payload)
+	if err != nil {
+		return err
+	}
+
+	db, err := stkAPI.processTransaction(ctx, &processParams{
+		TransactionID:  req.msg.TransactionId,
+		MpesaReceiptID: req.msg.MpesaReceiptId,
+		Processed:      req.msg.Processed,
+		Source:         stk.StkEventSource_STK_SOURCE_PROCESS_CHANNEL,
+		ActorID:        req.msg.ProcessorId,
+		Note:           req.msg.Note,
+	})
+	if err == nil || final(err) {
+		stkAPI.ackProcessRequest(ctx, req, db, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	stkAPI.Logger.Infof("Successfully processed transaction: %d", db.ID)
+
+	return nil
+}
+
+// ackProcessRequest publishes the outcome of the request to its reply channel
+func (stkAPI *stkAPIServer) ackProcessRequest(ctx context.Context, req *processRequest, db *STKTransaction, errProcess error) {
+	channel := firstVal(req.msg.ReplyChannel, stkAPI.ProcessReplyChannel)
+	if channel == "" {
+		return
+	}
+
+	ack := &stk.StkProcessAck{
+		Version:        ProcessRequestVersion,
+		RequestId:      req.msg.RequestId,
+		TransactionId:  req.msg.TransactionId,
+		MpesaReceiptId: req.msg.MpesaReceiptId,
+		Processed:      req.msg.Processed,
+		Succeeded:      errProcess == nil,
+		AckTimestamp:   stkAPI.NowFunc().Unix(),
+	}
+	if db != nil {
+		ack.TransactionId = uint64(db.ID)
+		ack.MpesaReceiptId = db.MpesaReceiptId.String
+	}
+	if errProcess != nil {
+		ack.Error = errProcess.Error()
+	}
+
+	var (
+		bs  []byte
+		err error
+	)
+	if req.format == processFormatProto {
+		bs, err = proto.Marshal(ack)
+	} else {
+		bs, err = protojson.Marshal(ack)
+	}
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to marshal process ack: %v", err)
+		return
+	}
+
+	err = stkAPI.Publisher.Publish(ctx, channel, bs)
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to publish process ack: %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+package stk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gidyon/mpesastk/pkg/streams"
+	"github.com/go-redis/redis/v8"
+	"github.com/nats-io/nats.go"
+	"github.com/segmentio/kafka-go"
+)
+
+// Publish modes decide how messages reach channel subscribers
+const (
+	// PublishModePubSub uses redis PUBLISH; messages are lost when no subscriber is connected
+	PublishModePubSub = "pubsub"
+	// PublishModeStreams appends messages to redis streams that are read through consumer groups
+	PublishModeStreams = "streams"
+	// PublishModeNATS publishes messages on NATS subjects named after the channel
+	PublishModeNATS = "nats"
+	// PublishModeKafka writes messages to kafka topics named after the channel
+	PublishModeKafka = "kafka"
+)
+
+const defaultStreamMaxLen = 100000
+
+func validPublishMode(mode string) bool {
+	switch mode {
+	case "", PublishModePubSub, PublishModeStreams, PublishModeNATS, PublishModeKafka:
+		return true
+	}
+	return false
+}
+
+// Publisher sends stk messages to subscribers of a channel
+type Publisher interface {
+	Publish(ctx context.Context, channel string, payload []byte) error
+	Close() error
+}
+
+// defaultPublisher returns the redis publisher for the publish mode. NATS and kafka publishers
+// need connections hence they must be passed in options.
+func defaultPublisher(opt *Options) (Publisher, error) {
+	switch opt.PublishMode {
+	case "", PublishModePubSub:
+		return NewRedisPublisher(opt.RedisDB), nil
+	case PublishModeStreams:
+		return NewStreamsPublisher(opt.RedisDB, opt.StreamMaxLen), nil
+	default:
+		return nil, fmt.Errorf("missing publisher for publish mode %s", opt.PublishMode)
+	}
+}
+
+type redisPublisher struct {
+	client redis.Cmdable
+}
+
+// NewRedisPublisher creates a publisher that uses redis PUBLISH
+func NewRedisPublisher(client redis.Cmdable) Publisher {
+	return &redisPublisher{client: client}
+}
+
+func (p *redisPublisher) Publish(ctx context.Context, channel string, payload []byte) error {
+	return p.client.Publish(ctx, channel, payload).Err()
+}
+
+func (p *redisPublisher) Close() error {
+	return nil
+}
+
+type streamsPublisher struct {
+	client redis.Cmdable
+	maxLen int64
+}
+
+// NewStreamsPublisher creates a publisher that appends messages to redis streams trimmed to about maxLen entries
+func NewStreamsPublisher(client redis.Cmdable, maxLen int64) Publisher {
+	if maxLen <= 0 {
+		maxLen = defaultStreamMaxLen
+	}
+	return &streamsPublisher{client: client, maxLen: maxLen}
+}
+
+func (p *streamsPublisher) Publish(ctx context.Context, channel string, payload []byte) error {
+	_, err := streams.Publish(ctx, p.client, channel, p.maxLen, payload)
+	if err != nil {
+		return fmt.Errorf("failed to add to stream: %v", err)
+	}
+	return nil
+}
+
+func (p *streamsPublisher) Close() error {
+	return nil
+}
+
+type natsPublisher struct {
+	conn *nats.Conn
+}
+
+// NewNATSPublisher creates a publisher that publishes on NATS subjects. The connection is closed with the publisher.
+func NewNATSPublisher(conn *nats.Conn) Publisher {
+	return &natsPublisher{conn: conn}
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, channel string, payload []byte) error {
+	err := p.conn.Publish(channel, payload)
+	if err != nil {
+		return err
+	}
+	// Publish only buffers the message; flushing reports whether the server got it
+	return p.conn.FlushWithContext(ctx)
+}
+
+func (p *natsPublisher) Close() error {
+	p.conn.Close()
+	return nil
+}
+
+// KafkaWriter writes messages to kafka. It is implemented by *kafka.Writer.
+type KafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+type kafkaPublisher struct {
+	writer KafkaWriter
+}
+
+// NewKafkaPublisher creates a publisher that writes to the topic named after the channel.
+// The writer must not have a topic set.
+func NewKafkaPublisher(writer KafkaWriter) Publisher {
+	return &kafkaPublisher{writer: writer}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, channel string, payload []byte) error {
+	if channel == "" {
+		return errors.New("missing kafka topic")
+	}
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: channel,
+		Value: payload,
+	})
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,194 @@
+package stk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gidyon/mpesastk/pkg/streams"
+	"github.com/go-redis/redis/v8"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	testChannel = "stk:test"
+	testPayload = `{"transaction_id":"1"}`
+)
+
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return mr, client
+}
+
+func TestRedisPublisher(t *testing.T) {
+	mr, client := newTestRedis(t)
+	ctx := context.Background()
+
+	sub := client.Subscribe(ctx, testChannel)
+	defer sub.Close()
+
+	_, err := sub.Receive(ctx)
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	p := NewRedisPublisher(client)
+
+	err = p.Publish(ctx, testChannel, []byte(testPayload))
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	select {
+	case msg := <-sub.Channel():
+		if msg.Channel != testChannel || msg.Payload != testPayload {
+			t.Errorf("received %s on %s, want %s on %s", msg.Payload, msg.Channel, testPayload, testChannel)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("message not received")
+	}
+
+	mr.SetError("ERR publish failed")
+
+	err = p.Publish(ctx, testChannel, []byte(testPayload))
+	if err == nil {
+		t.Error("Publish() error = nil, want redis error")
+	}
+}
+
+func TestStreamsPublisher(t *testing.T) {
+	mr, client := newTestRedis(t)
+	ctx := context.Background()
+
+	p := NewStreamsPublisher(client, 0)
+
+	err := p.Publish(ctx, testChannel, []byte(testPayload))
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	msgs, err := client.XRange(ctx, testChannel, "-", "+").Result()
+	if err != nil {
+		t.Fatalf("failed to read stream: %v", err)
+	}
+	if len(msgs) != 1 || msgs[0].Values[streams.PayloadField] != testPayload {
+		t.Errorf("stream entries = %v, want one entry with %s", msgs, testPayload)
+	}
+
+	mr.SetError("ERR xadd failed")
+
+	err = p.Publish(ctx, testChannel, []byte(testPayload))
+	if err == nil {
+		t.Error("Publish() error = nil, want redis error")
+	}
+}
+
+func TestNATSPublisher(t *testing.T) {
+	ns, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: server.RANDOM_PORT, NoLog: true, NoSigs: true})
+	if err != nil {
+		t.Fatalf("failed to create nats server: %v", err)
+	}
+	go ns.Start()
+	defer ns.Shutdown()
+
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+
+	conn, err := nats.Connect(ns.ClientURL())
+	if err != nil {
+		t.Fatalf("failed to connect to nats: %v", err)
+	}
+
+	sub, err := conn.SubscribeSync(testChannel)
+	if err != nil {
+		t.Fatalf("failed to subscribe: %v", err)
+	}
+
+	p := NewNATSPublisher(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = p.Publish(ctx, testChannel, []byte(testPayload))
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	if err != nil {
+		t.Fatalf("message not received: %v", err)
+	}
+	if string(msg.Data) != testPayload {
+		t.Errorf("received %s, want %s", msg.Data, testPayload)
+	}
+
+	// Closing the publisher closes the connection
+	err = p.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	err = p.Publish(ctx, testChannel, []byte(testPayload))
+	if !errors.Is(err, nats.ErrConnectionClosed) {
+		t.Errorf("Publish() error = %v, want %v", err, nats.ErrConnectionClosed)
+	}
+}
+
+type fakeKafkaWriter struct {
+	msgs   []kafka.Message
+	err    error
+	closed bool
+}
+
+func (w *fakeKafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.msgs = append(w.msgs, msgs...)
+	return nil
+}
+
+func (w *fakeKafkaWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestKafkaPublisher(t *testing.T) {
+	ctx := context.Background()
+	w := &fakeKafkaWriter{}
+	p := NewKafkaPublisher(w)
+
+	err := p.Publish(ctx, testChannel, []byte(testPayload))
+	if err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	if len(w.msgs) != 1 || w.msgs[0].Topic != testChannel || string(w.msgs[0].Value) != testPayload {
+		t.Errorf("written messages = %v, want one message with %s on %s", w.msgs, testPayload, testChannel)
+	}
+
+	err = p.Publish(ctx, "", []byte(testPayload))
+	if err == nil {
+		t.Error("Publish() without topic error = nil, want error")
+	}
+
+	w.err = errors.New("kafka unavailable")
+
+	err = p.Publish(ctx, testChannel, []byte(testPayload))
+	if !errors.Is(err, w.err) {
+		t.Errorf("Publish() error = %v, want %v", err, w.err)
+	}
+
+	err = p.Close()
+	if err != nil || !w.closed {
+		t.Errorf("Close() error = %v, closed = %v; want writer closed", err, w.closed)
+	}
+}
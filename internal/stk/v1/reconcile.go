@@ -0,0 +1,690 @@
+package stk
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/gidyon/mpesastk/pkg/statement"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReconcileTolerance = 5 * time.Minute
+	reconcileBatchSize        = 500
+	// amountEpsilon is the largest difference between amounts considered equal
+	amountEpsilon = 0.005
+)
+
+// STKReconciliation is a comparison of an M-Pesa organisation statement with stk transactions
+type STKReconciliation struct {
+	ID                      uint         `gorm:"primaryKey;autoIncrement"`
+	ActorID                 string       `gorm:"index;type:varchar(50);not null"`
+	ShortCode               string       `gorm:"index;type:varchar(15)"`
+	FileName                string       `gorm:"type:varchar(100)"`
+	StartTime               sql.NullTime `gorm:"type:datetime(6)"`
+	EndTime                 sql.NullTime `gorm:"type:datetime(6)"`
+	StatementRows           int64        `gorm:"type:bigint;not null;default:0"`
+	MatchedCount            int64        `gorm:"type:bigint;not null;default:0"`
+	MissingInDBCount        int64        `gorm:"type:bigint;not null;default:0"`
+	MissingInStatementCount int64        `gorm:"type:bigint;not null;default:0"`
+	AmountMismatchCount     int64        `gorm:"type:bigint;not null;default:0"`
+	StatusMismatchCount     int64        `gorm:"type:bigint;not null;default:0"`
+	CorrectedCount          int64        `gorm:"type:bigint;not null;default:0"`
+	UpdatedAt               time.Time    `gorm:"autoUpdateTime;type:datetime(6)"`
+	CreatedAt               time.Time    `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// ReconciliationsTable is table for reconciliations
+const ReconciliationsTable = "stk_reconciliations"
+
+// TableName returns the name of the table
+func (*STKReconciliation) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), ReconciliationsTable)
+	}
+	return ReconciliationsTable
+}
+
+// STKReconciliationItem is a row of a reconciliation report
+type STKReconciliationItem struct {
+	ID                uint          `gorm:"primaryKey;autoIncrement"`
+	ReconciliationID  uint          `gorm:"index;not null"`
+	Category          string        `gorm:"index;type:varchar(50);not null"`
+	MpesaReceiptID    string        `gorm:"index;type:varchar(50)"`
+	TransactionID     sql.NullInt64 `gorm:"index"`
+	StatementAmount   float64       `gorm:"type:decimal(15,2);not null;default:0"`
+	TransactionAmount float64       `gorm:"type:decimal(15,2);not null;default:0"`
+	PhoneNumber       string        `gorm:"type:varchar(15)"`
+	StatementTime     sql.NullTime  `gorm:"type:datetime(6)"`
+	TransactionTime   sql.NullTime  `gorm:"type:datetime(6)"`
+	Note              string        `gorm:"type:varchar(300)"`
+	CorrectedAt       sql.NullTime  `gorm:"type:datetime(6)"`
+	CreatedAt         time.Time     `gorm:"autoCreateTime;type:datetime(6);not null"`
+}
+
+// ReconciliationItemsTable is table for reconciliation items
+const ReconciliationItemsTable = "stk_reconciliation_items"
+
+// TableName returns the name of the table
+func (*STKReconciliationItem) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), ReconciliationItemsTable)
+	}
+	return ReconciliationItemsTable
+}
+
+// ReconciliationToProto returns the protobuf message of reconciliation
+func ReconciliationToProto(db *STKReconciliation) (*stk.Reconciliation, error) {
+	if db == nil {
+		return nil, errs.MissingField("reconciliation")
+	}
+
+	pb := &stk.Reconciliation{
+		ReconciliationId:        uint64(db.ID),
+		ActorId:                 db.ActorID,
+		ShortCode:               db.ShortCode,
+		FileName:                db.FileName,
+		StatementRows:           db.StatementRows,
+		MatchedCount:            db.MatchedCount,
+		MissingInDbCount:        db.MissingInDBCount,
+		MissingInStatementCount: db.MissingInStatementCount,
+		AmountMismatchCount:     db.AmountMismatchCount,
+		StatusMismatchCount:     db.StatusMismatchCount,
+		CorrectedCount:          db.CorrectedCount,
+		CreateTimestamp:         db.CreatedAt.UTC().Unix(),
+	}
+
+	if db.StartTime.Valid {
+		pb.StartTimestamp = db.StartTime.Time.UTC().Unix()
+	}
+	if db.EndTime.Valid {
+		pb.EndTimestamp = db.EndTime.Time.UTC().Unix()
+	}
+
+	return pb, nil
+}
+
+// ReconciliationItemToProto returns the protobuf message of reconciliation item
+func ReconciliationItemToProto(db *STKReconciliationItem) (*stk.ReconciliationItem, error) {
+	if db == nil {
+		return nil, errs.MissingField("reconciliation item")
+	}
+
+	pb := &stk.ReconciliationItem{
+		ItemId:            uint64(db.ID),
+		Category:          stk.ReconciliationCategory(stk.ReconciliationCategory_value[db.Category]),
+		MpesaReceiptId:    db.MpesaReceiptID,
+		TransactionId:     uint64(db.TransactionID.Int64),
+		StatementAmount:   db.StatementAmount,
+		TransactionAmount: db.TransactionAmount,
+		PhoneNumber:       db.PhoneNumber,
+		Note:              db.Note,
+	}
+
+	if db.StatementTime.Valid {
+		pb.StatementTimestamp = db.StatementTime.Time.UTC().Unix()
+	}
+	if db.TransactionTime.Valid {
+		pb.TransactionTimestamp = db.TransactionTime.Time.UTC().Unix()
+	}
+	if db.CorrectedAt.Valid {
+		pb.CorrectTimestamp = db.CorrectedAt.Time.UTC().Unix()
+	}
+
+	return pb, nil
+}
+
+// reconciler compares statement entries with stk transactions
+type reconciler struct {
+	stkAPI    *stkAPIServer
+	shortCode string
+	tolerance time.Duration
+	db        *STKReconciliation
+	items     []*STKReconciliationItem
+	// used are transactions matched to a statement entry
+	used map[uint]bool
+}
+
+func (r *reconciler) add(item *STKReconciliationItem) {
+	switch stk.ReconciliationCategory(stk.ReconciliationCategory_value[item.Category]) {
+	case stk.ReconciliationCategory_RECONCILIATION_MATCHED:
+		r.db.MatchedCount++
+	case stk.ReconciliationCategory_RECONCILIATION_MISSING_IN_DB:
+		r.db.MissingInDBCount++
+	case stk.ReconciliationCategory_RECONCILIATION_MISSING_IN_STATEMENT:
+		r.db.MissingInStatementCount++
+	case stk.ReconciliationCategory_RECONCILIATION_AMOUNT_MISMATCH:
+		r.db.AmountMismatchCount++
+	case stk.ReconciliationCategory_RECONCILIATION_STATUS_MISMATCH:
+		r.db.StatusMismatchCount++
+	}
+	item.Note = truncate(item.Note, 300)
+	r.items = append(r.items, item)
+}
+
+func (r *reconciler) scope(db *gorm.DB) *gorm.DB {
+	if r.shortCode != "" {
+		db = db.Where("short_code = ?", r.shortCode)
+	}
+	return db
+}
+
+// matchReceipts matches payments in the statement with transactions that have the same receipt
+func (r *reconciler) matchReceipts(entries []*statement.Entry) ([]*statement.Entry, error) {
+	unmatched := make([]*statement.Entry, 0)
+
+	for start := 0; start < len(entries); start += reconcileBatchSize {
+		end := start + reconcileBatchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		batch := entries[start:end]
+
+		receipts := make([]string, 0, len(batch))
+		for _, entry := range batch {
+			receipts = append(receipts, entry.ReceiptNo)
+		}
+
+		dbs := make([]*STKTransaction, 0, len(batch))
+
+		err := r.scope(r.stkAPI.SQLDB.Model(&STKTransaction{})).Find(&dbs, "mpesa_receipt_id IN(?)", receipts).Error
+		if err != nil {
+			return nil, err
+		}
+
+		byReceipt := make(map[string]*STKTransaction, len(dbs))
+		for _, db := range dbs {
+			byReceipt[strings.ToUpper(db.MpesaReceiptId.String)] = db
+		}
+
+		for _, entry := range batch {
+			db, ok := byReceipt[entry.ReceiptNo]
+			if !ok {
+				unmatched = append(unmatched, entry)
+				continue
+			}
+			r.used[db.ID] = true
+
+			item := entryItem(entry)
+			setTransaction(item, db)
+
+			switch {
+			case math.Abs(item.StatementAmount-item.TransactionAmount) > amountEpsilon:
+				item.Category = stk.ReconciliationCategory_RECONCILIATION_AMOUNT_MISMATCH.String()
+				item.Note = fmt.Sprintf("statement amount %.2f, transaction amount %.2f", item.StatementAmount, item.TransactionAmount)
+			case db.Succeeded != "YES":
+				item.Category = stk.ReconciliationCategory_RECONCILIATION_STATUS_MISMATCH.String()
+				item.Note = fmt.Sprintf("paid in statement but transaction is %s", StatusOf(db))
+			default:
+				item.Category = stk.ReconciliationCategory_RECONCILIATION_MATCHED.String()
+			}
+
+			r.add(item)
+		}
+	}
+
+	return unmatched, nil
+}
+
+// matchUnpaid matches a payment whose receipt is unknown with an unsuccessful transaction of the same
+// amount and phone that was initiated around the same time
+func (r *reconciler) matchUnpaid(entry *statement.Entry) error {
+	item := entryItem(entry)
+
+	// Statement times are East Africa Time while created_at is the instant in UTC
+	completed := time.Date(
+		entry.CompletionTime.Year(), entry.CompletionTime.Month(), entry.CompletionTime.Day(),
+		entry.CompletionTime.Hour(), entry.CompletionTime.Minute(), entry.CompletionTime.Second(), 0, eatLocation,
+	).UTC()
+
+	db := r.scope(r.stkAPI.SQLDB.Model(&STKTransaction{})).
+		Where("succeeded = ?", "NO").
+		Where("ABS(amount - ?) < ?", entry.PaidIn, amountEpsilon).
+		Where("created_at BETWEEN ? AND ?", completed.Add(-r.tolerance), completed.Add(r.tolerance)).
+		Order("created_at DESC")
+
+	if entry.PhoneNumber != "" {
+		db = db.Where("phone_number LIKE ?", strings.ReplaceAll(entry.PhoneNumber, "*", "_"))
+	}
+
+	if len(r.used) > 0 {
+		ids := make([]uint, 0, len(r.used))
+		for id := range r.used {
+			ids = append(ids, id)
+		}
+		db = db.Where("id NOT IN(?)", ids)
+	}
+
+	tx := &STKTransaction{}
+	err := db.First(tx).Error
+	switch {
+	case err == nil:
+		r.used[tx.ID] = true
+		setTransaction(item, tx)
+		item.Category = stk.ReconciliationCategory_RECONCILIATION_STATUS_MISMATCH.String()
+		item.Note = fmt.Sprintf("paid in statement but transaction is %s without receipt", StatusOf(tx))
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		item.Category = stk.ReconciliationCategory_RECONCILIATION_MISSING_IN_DB.String()
+		item.Note = "no stk transaction for payment"
+	default:
+		return err
+	}
+
+	r.add(item)
+
+	return nil
+}
+
+// matchTransactions finds successful transactions within the statement period that are not in the statement
+func (r *reconciler) matchTransactions(receipts map[string]bool, start, end time.Time) error {
+	var lastID uint
+
+	for {
+		dbs := make([]*STKTransaction, 0, reconcileBatchSize)
+
+		err := r.scope(r.stkAPI.SQLDB.Model(&STKTransaction{})).
+			Where("succeeded = ? AND transaction_time BETWEEN ? AND ? AND id > ?", "YES", start, end, lastID).
+			Order("id ASC").Limit(reconcileBatchSize).Find(&dbs).Error
+		if err != nil {
+			return err
+		}
+
+		for _, db := range dbs {
+			lastID = db.ID
+			if r.used[db.ID] || receipts[strings.ToUpper(db.MpesaReceiptId.String)] {
+				continue
+			}
+			item := &STKReconciliationItem{
+				Category:       stk.ReconciliationCategory_RECONCILIATION_MISSING_IN_STATEMENT.String(),
+				MpesaReceiptID: db.MpesaReceiptId.String,
+				Note:           "successful transaction is not in statement",
+			}
+			setTransaction(item, db)
+			r.add(item)
+		}
+
+		if len(dbs) < reconcileBatchSize {
+			return nil
+		}
+	}
+}
+
+func entryItem(entry *statement.Entry) *STKReconciliationItem {
+	return &STKReconciliationItem{
+		MpesaReceiptID:  entry.ReceiptNo,
+		StatementAmount: entry.PaidIn,
+		PhoneNumber:     entry.PhoneNumber,
+		StatementTime:   sql.NullTime{Time: entry.CompletionTime, Valid: true},
+	}
+}
+
+func setTransaction(item *STKReconciliationItem, db *STKTransaction) {
+	item.TransactionID = sql.NullInt64{Int64: int64(db.ID), Valid: true}
+	item.TransactionAmount, _ = strconv.ParseFloat(db.Amount, 64)
+	item.TransactionTime = db.TransactionTime
+	if item.PhoneNumber == "" {
+		item.PhoneNumber = db.PhoneNumber
+	}
+}
+
+func (stkAPI *stkAPIServer) RunReconciliation(ctx context.Context, req *stk.RunReconciliationRequest) (*stk.ReconciliationReport, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("reconciliation request")
+	case len(req.Statement) == 0:
+		return nil, errs.MissingField("statement")
+	case req.TimeToleranceSeconds < 0:
+		return nil, errs.IncorrectVal("time tolerance")
+	}
+
+	// Statement times are parsed as UTC like transaction times from callbacks
+	st, err := statement.Parse(bytes.NewReader(req.Statement), time.UTC)
+	if err != nil {
+		return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse statement")
+	}
+
+	r := &reconciler{
+		stkAPI:    stkAPI,
+		shortCode: firstVal(req.ShortCode, st.ShortCode),
+		tolerance: defaultReconcileTolerance,
+		used:      map[uint]bool{},
+	}
+	if req.TimeToleranceSeconds > 0 {
+		r.tolerance = time.Duration(req.TimeToleranceSeconds) * time.Second
+	}
+
+	r.db = &STKReconciliation{
+		ActorID:   actor.ID,
+		ShortCode: r.shortCode,
+		FileName:  truncate(req.FileName, 100),
+	}
+
+	// Only completed payments into the short code are compared
+	var (
+		entries  = make([]*statement.Entry, 0, len(st.Entries))
+		receipts = make(map[string]bool, len(st.Entries))
+	)
+	for _, entry := range st.Entries {
+		receipts[entry.ReceiptNo] = true
+		if entry.PaidIn <= 0 || !entry.Completed() {
+			continue
+		}
+		entries = append(entries, entry)
+
+		if !r.db.StartTime.Valid || entry.CompletionTime.Before(r.db.StartTime.Time) {
+			r.db.StartTime = sql.NullTime{Time: entry.CompletionTime, Valid: true}
+		}
+		if !r.db.EndTime.Valid || entry.CompletionTime.After(r.db.EndTime.Time) {
+			r.db.EndTime = sql.NullTime{Time: entry.CompletionTime, Valid: true}
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "statement has no completed payments")
+	}
+
+	r.db.StatementRows = int64(len(entries))
+
+	unmatched, err := r.matchReceipts(entries)
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to match statement receipts")
+	}
+
+	for _, entry := range unmatched {
+		err = r.matchUnpaid(entry)
+		if err != nil {
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to match statement payments")
+		}
+	}
+
+	err = r.matchTransactions(receipts, r.db.StartTime.Time, r.db.EndTime.Time)
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to match stk transactions")
+	}
+
+	err = stkAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(r.db).Error
+		if err != nil {
+			return err
+		}
+		if len(r.items) == 0 {
+			return nil
+		}
+		for _, item := range r.items {
+			item.ReconciliationID = r.db.ID
+		}
+		return tx.CreateInBatches(r.items, reconcileBatchSize).Error
+	})
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to save reconciliation")
+	}
+
+	return stkAPI.reconciliationReport(r.db, nil, 0, 0)
+}
+
+// reconciliationReport returns the reconciliation with a page of its items after item id afterID
+func (stkAPI *stkAPIServer) reconciliationReport(
+	db *STKReconciliation, categories []stk.ReconciliationCategory, afterID uint64, pageSize int32,
+) (*stk.ReconciliationReport, error) {
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	// Items are listed in the order of the statement
+	query := stkAPI.SQLDB.Model(&STKReconciliationItem{}).Where("reconciliation_id = ?", db.ID).
+		Order("id ASC").Limit(int(pageSize) + 1)
+	if afterID != 0 {
+		query = query.Where("id > ?", afterID)
+	}
+
+	if len(categories) > 0 {
+		cs := make([]string, 0, len(categories))
+		for _, c := range categories {
+			cs = append(cs, c.String())
+		}
+		query = query.Where("category IN(?)", cs)
+	}
+
+	dbs := make([]*STKReconciliationItem, 0, pageSize+1)
+
+	err := query.Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list reconciliation items")
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		dbs = dbs[:pageSize]
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(dbs[len(dbs)-1].ID)))
+	}
+
+	pb, err := ReconciliationToProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	pbs := make([]*stk.ReconciliationItem, 0, len(dbs))
+
+	for _, db := range dbs {
+		item, err := ReconciliationItemToProto(db)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, item)
+	}
+
+	return &stk.ReconciliationReport{
+		Reconciliation: pb,
+		Items:          pbs,
+		NextPageToken:  token,
+	}, nil
+}
+
+func (stkAPI *stkAPIServer) getReconciliation(id uint64) (*STKReconciliation, error) {
+	db := &STKReconciliation{}
+	err := stkAPI.SQLDB.First(db, "id = ?", id).Error
+	switch {
+	case err == nil:
+		return db, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("reconciliation", fmt.Sprint(id))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get reconciliation")
+	}
+}
+
+func (stkAPI *stkAPIServer) GetReconciliationReport(
+	ctx context.Context, req *stk.GetReconciliationReportRequest,
+) (*stk.ReconciliationReport, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("get request")
+	case req.ReconciliationId == 0:
+		return nil, errs.MissingField("reconciliation id")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	db, err := stkAPI.getReconciliation(req.ReconciliationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return stkAPI.reconciliationReport(db, req.Categories, id, req.PageSize)
+}
+
+func (stkAPI *stkAPIServer) CorrectReconciliationItems(
+	ctx context.Context, req *stk.CorrectReconciliationItemsRequest,
+) (*stk.CorrectReconciliationItemsResponse, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("correct request")
+	case req.ReconciliationId == 0:
+		return nil, errs.MissingField("reconciliation id")
+	}
+
+	recon, err := stkAPI.getReconciliation(req.ReconciliationId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only payments found in the statement whose transaction was not successful can be corrected
+	query := stkAPI.SQLDB.Where("reconciliation_id = ? AND category = ? AND corrected_at IS NULL",
+		recon.ID, stk.ReconciliationCategory_RECONCILIATION_STATUS_MISMATCH.String()).Order("id ASC")
+	if len(req.ItemIds) > 0 {
+		query = query.Where("id IN(?)", req.ItemIds)
+	}
+
+	items := make([]*STKReconciliationItem, 0)
+
+	err = query.Find(&items).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get reconciliation items")
+	}
+
+	res := &stk.CorrectReconciliationItemsResponse{}
+
+	found := make(map[uint64]bool, len(items))
+	for _, item := range items {
+		found[uint64(item.ID)] = true
+	}
+	for _, id := range req.ItemIds {
+		if !found[id] {
+			res.FailedItemIds = append(res.FailedItemIds, id)
+		}
+	}
+
+	for _, item := range items {
+		err = stkAPI.correctItem(ctx, recon, item, actor.ID)
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to correct reconciliation item %d: %v", item.ID, err)
+			res.FailedItemIds = append(res.FailedItemIds, uint64(item.ID))
+			continue
+		}
+		res.CorrectedCount++
+	}
+
+	if res.CorrectedCount > 0 {
+		err = stkAPI.SQLDB.Model(recon).Update("corrected_count", gorm.Expr("corrected_count + ?", res.CorrectedCount)).Error
+		if err != nil {
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to update reconciliation")
+		}
+	}
+
+	return res, nil
+}
+
+// correctItem marks the transaction of the item as successful with the receipt from the statement
+func (stkAPI *stkAPIServer) correctItem(ctx context.Context, recon *STKReconciliation, item *STKReconciliationItem, actorID string) error {
+	db := &STKTransaction{}
+	err := stkAPI.SQLDB.First(db, "id = ?", item.TransactionID.Int64).Error
+	if err != nil {
+		return err
+	}
+
+	if db.MpesaReceiptId.Valid && db.MpesaReceiptId.String != "" && !strings.EqualFold(db.MpesaReceiptId.String, item.MpesaReceiptID) {
+		return fmt.Errorf("transaction has receipt %s", db.MpesaReceiptId.String)
+	}
+
+	var (
+		initReq = &stk.InitiateSTKRequest{}
+		outbox  = stkAPI.newOutboxWriter(initReq)
+	)
+
+	// Get the request that initiated this STK
+	if db.CheckoutRequestID.Valid {
+		bs, err := stkAPI.RedisDB.Get(ctx, GetMpesaRequestKey(db.CheckoutRequestID.String)).Result()
+		if err == nil {
+			err = proto.Unmarshal([]byte(bs), initReq)
+			if err != nil {
+				stkAPI.Logger.Errorln("Failed to unmarshal initiate stk request: ", err)
+			}
+		}
+	}
+
+	err = TransitionStatus(stkAPI.SQLDB, db, &StatusTransition{
+		To:          stk.StkStatus_STK_SUCCESS,
+		Source:      stk.StkEventSource_STK_SOURCE_RECONCILIATION,
+		Description: fmt.Sprintf("paid in statement of reconciliation %d", recon.ID),
+		ActorID:     actorID,
+		Updates: map[string]interface{}{
+			"mpesa_receipt_id": item.MpesaReceiptID,
+			"succeeded":        "YES",
+			"transaction_time": item.StatementTime,
+		},
+		Hooks: []TxHook{
+			outbox.hook,
+			func(tx *gorm.DB, _ *STKTransaction) error {
+				return tx.Model(item).Update("corrected_at", stkAPI.NowFunc().UTC()).Error
+			},
+		},
+		Correction: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish messages were saved with the update
+	outbox.flush()
+
+	stkAPI.notifyUpdate(ctx, stk.StkTransactionEventType_STK_TRANSACTION_UPDATED, db.ID)
+
+	return nil
+}
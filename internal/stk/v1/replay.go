@@ -0,0 +1,240 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/gidyon/mpesastk/pkg/payload"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultReplayLimit = 100
+	maxReplayLimit     = 1000
+)
+
+func (stkAPI *stkAPIServer) ReplayCallbacks(
+	ctx context.Context, req *stk.ReplayCallbacksRequest,
+) (*stk.ReplayCallbacksResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := req.GetFilter()
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("replay request")
+	case filter == nil:
+		return nil, errs.MissingField("filter")
+	case len(filter.CheckoutRequestIds) == 0 && (filter.StartTimestamp <= 0 || filter.EndTimestamp <= 0):
+		return nil, errs.MissingField("filter time range or checkout ids")
+	case filter.EndTimestamp > 0 && filter.EndTimestamp < filter.StartTimestamp:
+		return nil, errs.IncorrectVal("filter time range")
+	case req.Limit < 0:
+		return nil, errs.IncorrectVal("limit")
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit == 0:
+		limit = defaultReplayLimit
+	case limit > maxReplayLimit:
+		limit = maxReplayLimit
+	}
+
+	db := filterCallbacks(stkAPI.SQLDB.Model(&STKCallback{}), filter)
+
+	// Rejected callbacks are only replayed when asked for explicitly
+	if len(filter.States) == 0 {
+		db = db.Where("state <> ?", stk.StkCallbackState_STK_CALLBACK_REJECTED.String())
+	}
+
+	dbs := make([]*STKCallback, 0, limit)
+
+	err = db.Order("id ASC").Limit(limit).Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list stk callbacks")
+	}
+
+	res := &stk.ReplayCallbacksResponse{
+		DryRun:  req.DryRun,
+		Replays: make([]*stk.StkCallbackReplay, 0, len(dbs)),
+	}
+
+	for _, db := range dbs {
+		replay := stkAPI.replayCallback(ctx, db, req.DryRun)
+		switch {
+		case replay.Applied:
+			res.AppliedCount++
+		case replay.Error != "":
+			res.FailedCount++
+		}
+		res.Replays = append(res.Replays, replay)
+	}
+
+	return res, nil
+}
+
+// replayCallback computes changes of the stored callback and applies them unless it is a dry run
+func (stkAPI *stkAPIServer) replayCallback(ctx context.Context, db *STKCallback, dryRun bool) *stk.StkCallbackReplay {
+	replay := &stk.StkCallbackReplay{
+		CallbackId:        uint64(db.ID),
+		CheckoutRequestId: db.CheckoutRequestID.String,
+	}
+
+	callback := &payload.STKPayload{}
+
+	err := json.Unmarshal([]byte(db.Body), callback)
+	if err == nil {
+		err = ValidateCallback(callback)
+	}
+	if err != nil {
+		replay.Error = fmt.Sprintf("incorrect stk callback: %v", err)
+		return replay
+	}
+
+	replay.CheckoutRequestId = callback.Body.STKCallback.CheckoutRequestID
+
+	// Replayed callbacks must pass the same verification as received callbacks
+	err = stkAPI.verifyCallback(ctx, callback, db.CallbackTokenHash.String)
+	if err != nil {
+		replay.Error = err.Error()
+		if !dryRun && status.Code(err) == codes.PermissionDenied {
+			stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_REJECTED, nil, err)
+		}
+		return replay
+	}
+
+	err = stkAPI.previewCallback(callback, replay)
+	if err != nil {
+		replay.Error = err.Error()
+		return replay
+	}
+
+	if dryRun {
+		return replay
+	}
+
+	pb, err := stkAPI.processCallback(ctx, callback)
+	switch {
+	case err == nil:
+		replay.Applied = true
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_PROCESSED, pb, nil)
+	case errors.Is(err, errCallbackIgnored):
+		replay.Error = err.Error()
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_PROCESSED, pb, err)
+	default:
+		replay.Error = err.Error()
+		stkAPI.finishCallback(db, stk.StkCallbackState_STK_CALLBACK_FAILED, nil, err)
+	}
+
+	if pb != nil {
+		replay.TransactionId = pb.TransactionId
+	}
+
+	return replay
+}
+
+// previewCallback sets changes that applying the callback would make to its transaction
+func (stkAPI *stkAPIServer) previewCallback(callback *payload.STKPayload, replay *stk.StkCallbackReplay) error {
+	db := &STKTransaction{}
+
+	err := stkAPI.SQLDB.First(db, "checkout_request_id = ?", callback.Body.STKCallback.CheckoutRequestID).Error
+	switch {
+	case err == nil:
+		replay.TransactionId = uint64(db.ID)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		replay.CreatesTransaction = true
+	default:
+		stkAPI.Logger.Errorln(err)
+		return errors.New("failed to get stk transaction")
+	}
+
+	status, updates, err := callbackUpdates(db, callback)
+	if err != nil {
+		return err
+	}
+
+	replay.FromStatus = StatusOf(db)
+	replay.ToStatus = status
+
+	if !CanTransition(replay.FromStatus, status) {
+		return fmt.Errorf("%w: %s to %s", ErrIllegalTransition, replay.FromStatus, status)
+	}
+
+	updates["stk_status"] = status.String()
+
+	replay.Changes = diffColumns(transactionColumns(db), updates)
+
+	return nil
+}
+
+// transactionColumns returns values of columns that callbacks update
+func transactionColumns(db *STKTransaction) map[string]interface{} {
+	return map[string]interface{}{
+		"result_code":        db.ResultCode,
+		"result_description": db.ResultDescription,
+		"mpesa_receipt_id":   db.MpesaReceiptId,
+		"transaction_time":   db.TransactionTime,
+		"balance":            db.Balance,
+		"callback_extras":    db.CallbackExtras,
+		"succeeded":          db.Succeeded,
+		"stk_status":         db.StkStatus,
+	}
+}
+
+// diffColumns returns columns whose values in updates differ from current values
+func diffColumns(current, updates map[string]interface{}) []*stk.StkFieldChange {
+	fields := make([]string, 0, len(updates))
+	for field := range updates {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	changes := make([]*stk.StkFieldChange, 0, len(fields))
+
+	for _, field := range fields {
+		oldVal, newVal := columnString(current[field]), columnString(updates[field])
+		if oldVal != newVal {
+			changes = append(changes, &stk.StkFieldChange{
+				Field:    field,
+				OldValue: oldVal,
+				NewValue: newVal,
+			})
+		}
+	}
+
+	return changes
+}
+
+func columnString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case sql.NullString:
+		return val.String
+	case sql.NullTime:
+		if !val.Valid {
+			return ""
+		}
+		return val.Time.UTC().Format(time.RFC3339)
+	case string:
+		return val
+	default:
+		return fmt.Sprint(val)
+	}
+}
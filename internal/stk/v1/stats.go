@@ -0,0 +1,303 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	// statsGroupLimit is the maximum number of groups returned per dimension; the largest groups come first
+	statsGroupLimit = 100
+	// statsTimeLimit is the maximum number of time buckets returned; the earliest buckets come first
+	statsTimeLimit  = 1000
+	statsTimeLayout = "2006-01-02 15:04:05"
+)
+
+// statsRow is an aggregate of stk transactions sharing a key
+type statsRow struct {
+	Key             sql.NullString
+	Count           int64
+	Amount          float64
+	SucceededCount  int64
+	SucceededAmount float64
+}
+
+const statsColumns = "COUNT(*) AS count, COALESCE(SUM(amount), 0) AS amount, " +
+	"COALESCE(SUM(succeeded = 'YES'), 0) AS succeeded_count, " +
+	"COALESCE(SUM(CASE WHEN succeeded = 'YES' THEN amount ELSE 0 END), 0) AS succeeded_amount"
+
+// statsGroup is the query of groups of a dimension
+type statsGroup struct {
+	dest *[]*stk.StkStatsGroup
+	db   *gorm.DB
+}
+
+func (r *statsRow) toProto(key string) *stk.StkStatsGroup {
+	return &stk.StkStatsGroup{
+		Key:             key,
+		Count:           r.Count,
+		Amount:          r.Amount,
+		SucceededCount:  r.SucceededCount,
+		SucceededAmount: r.SucceededAmount,
+	}
+}
+
+// statsBucket returns the DATE_FORMAT pattern of the start of time buckets
+func statsBucket(interval stk.StkStatsInterval) (stk.StkStatsInterval, string) {
+	switch interval {
+	case stk.StkStatsInterval_STK_STATS_HOUR:
+		return interval, "%Y-%m-%d %H:00:00"
+	case stk.StkStatsInterval_STK_STATS_MONTH:
+		return interval, "%Y-%m-01 00:00:00"
+	default:
+		return stk.StkStatsInterval_STK_STATS_DAY, "%Y-%m-%d 00:00:00"
+	}
+}
+
+// zoneOffset returns the offset of the location at t in the +hh:mm format CONVERT_TZ expects
+func zoneOffset(t time.Time) string {
+	_, offset := t.Zone()
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// zoneSpan is a period in which a location keeps the same utc offset
+type zoneSpan struct {
+	// until is when the offset changes; zero for the last span
+	until  time.Time
+	offset string
+}
+
+// zoneSpans returns the utc offsets of the location between start and end, e.g. before and after
+// daylight saving time changes
+func zoneSpans(loc *time.Location, start, end time.Time) []zoneSpan {
+	var (
+		spans  = make([]zoneSpan, 0, 1)
+		offset = func(t time.Time) int {
+			_, off := t.In(loc).Zone()
+			return off
+		}
+	)
+
+	from := start
+	for t := start; t.Before(end); {
+		next := t.Add(24 * time.Hour)
+		if next.After(end) {
+			next = end
+		}
+		if offset(next) != offset(t) {
+			// Find the second the offset changes
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if offset(mid) == offset(lo) {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			// Offsets change on whole seconds
+			hi = hi.Truncate(time.Second)
+			spans = append(spans, zoneSpan{until: hi, offset: zoneOffset(from.In(loc))})
+			from = hi
+		}
+		t = next
+	}
+
+	return append(spans, zoneSpan{offset: zoneOffset(from.In(loc))})
+}
+
+// localTimeExpr returns the sql expression that converts the utc column to local time of the spans
+func localTimeExpr(col string, spans []zoneSpan) (string, []interface{}) {
+	if len(spans) == 1 {
+		return "CONVERT_TZ(" + col + ", '+00:00', ?)", []interface{}{spans[0].offset}
+	}
+
+	var (
+		expr = "CONVERT_TZ(" + col + ", '+00:00', CASE"
+		args = make([]interface{}, 0, len(spans)*2)
+	)
+	for _, span := range spans[:len(spans)-1] {
+		expr += " WHEN " + col + " < ? THEN ?"
+		args = append(args, span.until, span.offset)
+	}
+	expr += " ELSE ? END)"
+	args = append(args, spans[len(spans)-1].offset)
+
+	return expr, args
+}
+
+func (stkAPI *stkAPIServer) GetStkStats(ctx context.Context, req *stk.GetStkStatsRequest) (*stk.StkStats, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	if req == nil {
+		return nil, errs.MissingField("stats request")
+	}
+
+	loc := time.UTC
+	if req.TimeZone != "" {
+		loc, err = time.LoadLocation(req.TimeZone)
+		if err != nil {
+			return nil, errs.IncorrectVal("time zone")
+		}
+	}
+
+	// Read from redis list of phone numbers
+	allowedPhones, err := stkAPI.allowedPhones(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := req.GetFilter()
+
+	db := stkAPI.SQLDB.Model(&STKTransaction{})
+
+	if len(allowedPhones) > 0 {
+		db = db.Where("phone_number IN(?)", allowedPhones)
+	}
+
+	db, err = filterTransactions(db, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, bucket := statsBucket(req.Interval)
+	_, timeCol, _ := transactionsOrder(filter)
+
+	res := &stk.StkStats{
+		Interval: interval,
+		TimeZone: loc.String(),
+	}
+
+	// Totals
+	total := &statsRow{}
+	err = db.Session(&gorm.Session{}).Select(statsColumns).Scan(total).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk stats")
+	}
+	res.Count, res.Amount, res.SucceededCount, res.SucceededAmount = total.Count, total.Amount, total.SucceededCount, total.SucceededAmount
+
+	err = db.Session(&gorm.Session{}).
+		Where("succeeded = ? AND stk_status IN(?)", "NO", []string{
+			stk.StkStatus_STK_REQUEST_FAILED.String(),
+			stk.StkStatus_STK_RESULT_FAILED.String(),
+			stk.StkStatus_STK_FAILED.String(),
+		}).
+		Count(&res.FailedCount).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk stats")
+	}
+
+	if final := res.SucceededCount + res.FailedCount; final > 0 {
+		res.SuccessRate = float64(res.SucceededCount) / float64(final)
+	}
+
+	// Average time from push to the first callback received for the transaction
+	callbacks := stkAPI.SQLDB.Model(&STKTransactionEvent{}).
+		Select("transaction_id, MIN(created_at) AS callback_at").
+		Where("source = ?", stk.StkEventSource_STK_SOURCE_CALLBACK.String()).
+		Group("transaction_id")
+
+	table := (&STKTransaction{}).TableName()
+
+	var avg sql.NullFloat64
+	err = db.Session(&gorm.Session{}).
+		Joins("JOIN (?) AS cb ON cb.transaction_id = "+table+".id", callbacks).
+		Select("AVG(TIMESTAMPDIFF(MICROSECOND, " + table + ".created_at, cb.callback_at)) / 1000000").
+		Scan(&avg).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk stats")
+	}
+	res.AverageCallbackSeconds = avg.Float64
+
+	// Groups
+	groups := []statsGroup{
+		{
+			dest: &res.ByStatus,
+			db:   db.Session(&gorm.Session{}).Select("stk_status AS `key`, " + statsColumns).Order("count DESC").Limit(statsGroupLimit),
+		},
+		{
+			dest: &res.ByShortCode,
+			db:   db.Session(&gorm.Session{}).Select("short_code AS `key`, " + statsColumns).Order("count DESC").Limit(statsGroupLimit),
+		},
+		{
+			dest: &res.ByInitiator,
+			db:   db.Session(&gorm.Session{}).Select("initiator_id AS `key`, " + statsColumns).Order("count DESC").Limit(statsGroupLimit),
+		},
+		{
+			dest: &res.ByResultCode,
+			db: db.Session(&gorm.Session{}).Select("result_code AS `key`, "+statsColumns).
+				Where("succeeded = ? AND result_code IS NOT NULL", "NO").Order("count DESC").Limit(statsGroupLimit),
+		},
+	}
+
+	// Offsets of the time zone over the period of the transactions, which differ across daylight saving changes
+	var period struct {
+		PeriodStart sql.NullTime
+		PeriodEnd   sql.NullTime
+	}
+	err = db.Session(&gorm.Session{}).
+		Select("MIN(" + timeCol + ") AS period_start, MAX(" + timeCol + ") AS period_end").Scan(&period).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk stats")
+	}
+
+	if period.PeriodStart.Valid && period.PeriodEnd.Valid {
+		localTime, args := localTimeExpr(timeCol, zoneSpans(loc, period.PeriodStart.Time.UTC(), period.PeriodEnd.Time.UTC()))
+		groups = append(groups, statsGroup{
+			dest: &res.ByTime,
+			db: db.Session(&gorm.Session{}).
+				Select("DATE_FORMAT("+localTime+", ?) AS `key`, "+statsColumns, append(args, bucket)...).
+				Where(timeCol + " IS NOT NULL").Order("`key`").Limit(statsTimeLimit),
+		})
+	} else {
+		res.ByTime = []*stk.StkStatsGroup{}
+	}
+
+	for _, group := range groups {
+		rows := make([]*statsRow, 0)
+
+		err = group.db.Group("`key`").Scan(&rows).Error
+		if err != nil {
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to get stk stats")
+		}
+
+		*group.dest = make([]*stk.StkStatsGroup, 0, len(rows))
+
+		for _, row := range rows {
+			key := row.Key.String
+			if group.dest == &res.ByTime {
+				t, err := time.ParseInLocation(statsTimeLayout, key, loc)
+				if err != nil {
+					stkAPI.Logger.Errorln(err)
+					return nil, errs.WrapMessage(codes.Internal, "failed to get stk stats")
+				}
+				key = t.Format(time.RFC3339)
+			}
+			*group.dest = append(*group.dest, row.toProto(key))
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,80 @@
+package stk
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestZoneSpans(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	// Daylight saving time started at 2024-03-10 07:00 UTC and ended at 2024-11-03 06:00 UTC
+	var (
+		dstStart = time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC)
+		dstEnd   = time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name       string
+		loc        *time.Location
+		start, end time.Time
+		want       []zoneSpan
+	}{
+		{
+			name:  "fixed offset",
+			loc:   eatLocation,
+			start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:  []zoneSpan{{offset: "+03:00"}},
+		},
+		{
+			name:  "no change in period",
+			loc:   newYork,
+			start: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+			want:  []zoneSpan{{offset: "-04:00"}},
+		},
+		{
+			name:  "daylight saving start",
+			loc:   newYork,
+			start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			want:  []zoneSpan{{until: dstStart, offset: "-05:00"}, {offset: "-04:00"}},
+		},
+		{
+			name:  "daylight saving start and end",
+			loc:   newYork,
+			start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:  []zoneSpan{{until: dstStart, offset: "-05:00"}, {until: dstEnd, offset: "-04:00"}, {offset: "-05:00"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := zoneSpans(tt.loc, tt.start, tt.end)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("zoneSpans() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalTimeExpr(t *testing.T) {
+	until := time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC)
+
+	expr, args := localTimeExpr("created_at", []zoneSpan{{offset: "+03:00"}})
+	if expr != "CONVERT_TZ(created_at, '+00:00', ?)" || !reflect.DeepEqual(args, []interface{}{"+03:00"}) {
+		t.Errorf("localTimeExpr() = %s %v", expr, args)
+	}
+
+	expr, args = localTimeExpr("created_at", []zoneSpan{{until: until, offset: "-05:00"}, {offset: "-04:00"}})
+	if expr != "CONVERT_TZ(created_at, '+00:00', CASE WHEN created_at < ? THEN ? ELSE ? END)" ||
+		!reflect.DeepEqual(args, []interface{}{until, "-05:00", "-04:00"}) {
+		t.Errorf("localTimeExpr() = %s %v", expr, args)
+	}
+}
@@ -0,0 +1,373 @@
+package stk
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// ErrIllegalTransition is returned when the stk status cannot move to the requested status
+var ErrIllegalTransition = errors.New("illegal stk status transition")
+
+// statusTransitions lists statuses that each status can move to.
+//
+// Callbacks are the authoritative outcome of a stk push hence they may replace query results and
+// request failures, e.g. when daraja accepted a request whose acknowledgement timed out. A success
+// confirmed by query is never turned into a failure. Statuses without transitions are final.
+var statusTransitions = map[stk.StkStatus][]stk.StkStatus{
+	stk.StkStatus_STK_STATUS_UNKNOWN: {
+		stk.StkStatus_STK_REQUEST_SUBMITED,
+		stk.StkStatus_STK_SUCCESS,
+		stk.StkStatus_STK_FAILED,
+	},
+	stk.StkStatus_STK_REQUEST_SUBMITED: {
+		stk.StkStatus_STK_REQUEST_SUCCESS,
+		stk.StkStatus_STK_REQUEST_FAILED,
+		stk.StkStatus_STK_RESULT_SUCCESS,
+		stk.StkStatus_STK_RESULT_FAILED,
+		stk.StkStatus_STK_SUCCESS,
+		stk.StkStatus_STK_FAILED,
+	},
+	stk.StkStatus_STK_REQUEST_SUCCESS: {
+		stk.StkStatus_STK_RESULT_SUCCESS,
+		stk.StkStatus_STK_RESULT_FAILED,
+		stk.StkStatus_STK_SUCCESS,
+		stk.StkStatus_STK_FAILED,
+	},
+	stk.StkStatus_STK_REQUEST_FAILED: {
+		stk.StkStatus_STK_SUCCESS,
+		stk.StkStatus_STK_FAILED,
+	},
+	stk.StkStatus_STK_RESULT_SUCCESS: {
+		stk.StkStatus_STK_SUCCESS,
+	},
+	stk.StkStatus_STK_RESULT_FAILED: {
+		stk.StkStatus_STK_SUCCESS,
+		stk.StkStatus_STK_FAILED,
+	},
+}
+
+// CanTransition checks whether a transaction in status from can move to status to
+func CanTransition(from, to stk.StkStatus) bool {
+	for _, s := range statusTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinalStatus checks whether the stk status will not change again
+func IsFinalStatus(status stk.StkStatus) bool {
+	return status != stk.StkStatus_STK_STATUS_UNKNOWN && len(statusTransitions[status]) == 0
+}
+
+// IsSettledStatus checks whether the stk status carries an outcome for the payer.
+// Unlike final statuses, request failures and query results may still be replaced by a callback.
+func IsSettledStatus(status stk.StkStatus) bool {
+	switch status {
+	case stk.StkStatus_STK_REQUEST_FAILED, stk.StkStatus_STK_RESULT_SUCCESS, stk.StkStatus_STK_RESULT_FAILED:
+		return true
+	}
+	return IsFinalStatus(status)
+}
+
+// StatusOf returns the stk status of the transaction
+func StatusOf(db *STKTransaction) stk.StkStatus {
+	return stk.StkStatus(stk.StkStatus_value[db.StkStatus.String])
+}
+
+// STKTransactionEvent is a change in status or processed state of stk transaction
+type STKTransactionEvent struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement"`
+	TransactionID uint           `gorm:"index;not null"`
+	FromStatus    string         `gorm:"type:varchar(30)"`
+	ToStatus      string         `gorm:"index;type:varchar(30)"`
+	Source        string         `gorm:"index;type:varchar(30)"`
+	Description   sql.NullString `gorm:"type:varchar(300)"`
+	ActorID       sql.NullString `gorm:"type:varchar(50)"`
+	CreatedAt     time.Time      `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// StkEventsTable is table for stk transaction events
+const StkEventsTable = "stk_transaction_events"
+
+// TableName returns the name of the table
+func (*STKTransactionEvent) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), StkEventsTable)
+	}
+	return StkEventsTable
+}
+
+// EventToProto returns the protobuf message of stk transaction event
+func EventToProto(db *STKTransactionEvent) (*stk.StkTransactionEvent, error) {
+	if db == nil {
+		return nil, errs.MissingField("stk transaction event")
+	}
+
+	return &stk.StkTransactionEvent{
+		EventId:         uint64(db.ID),
+		TransactionId:   uint64(db.TransactionID),
+		FromStatus:      stk.StkStatus(stk.StkStatus_value[db.FromStatus]),
+		ToStatus:        stk.StkStatus(stk.StkStatus_value[db.ToStatus]),
+		Source:          stk.StkEventSource(stk.StkEventSource_value[db.Source]),
+		Description:     db.Description.String,
+		ActorId:         db.ActorID.String,
+		CreateTimestamp: db.CreatedAt.UTC().Unix(),
+	}, nil
+}
+
+// StatusTransition describes a change of stk transaction status
+type StatusTransition struct {
+	To          stk.StkStatus
+	Source      stk.StkEventSource
+	Description string
+	ActorID     string
+	// Updates are other columns saved together with the status
+	Updates map[string]interface{}
+	// Hooks run in the same database transaction once the status is saved
+	Hooks []TxHook
+	// Correction skips transition rules; it is set for corrections backed by external evidence such as
+	// an M-Pesa statement
+	Correction bool
+}
+
+// TxHook writes rows that must be committed together with a status change, e.g. outbox entries.
+// It receives the saved transaction.
+type TxHook func(tx *gorm.DB, db *STKTransaction) error
+
+func runHooks(tx *gorm.DB, id uint, hooks []TxHook) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	saved := &STKTransaction{}
+	err := tx.First(saved, "id = ?", id).Error
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		err = hook(tx, saved)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newEvent(transactionID uint, from stk.StkStatus, t *StatusTransition) *STKTransactionEvent {
+	return &STKTransactionEvent{
+		TransactionID: transactionID,
+		FromStatus:    from.String(),
+		ToStatus:      t.To.String(),
+		Source:        t.Source.String(),
+		Description:   sql.NullString{String: truncate(t.Description, 300), Valid: t.Description != ""},
+		ActorID:       sql.NullString{String: t.ActorID, Valid: t.ActorID != ""},
+	}
+}
+
+// CreateTransaction saves a new stk transaction and records the transition from unknown status
+func CreateTransaction(sqlDB *gorm.DB, db *STKTransaction, source stk.StkEventSource, description string, hooks ...TxHook) error {
+	to := StatusOf(db)
+	if !CanTransition(stk.StkStatus_STK_STATUS_UNKNOWN, to) {
+		return fmt.Errorf("%w: cannot create transaction with status %s", ErrIllegalTransition, to)
+	}
+
+	return sqlDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(db).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Create(newEvent(db.ID, stk.StkStatus_STK_STATUS_UNKNOWN, &StatusTransition{
+			To:          to,
+			Source:      source,
+			Description: description,
+		})).Error
+		if err != nil {
+			return err
+		}
+		return runHooks(tx, db.ID, hooks)
+	})
+}
+
+// maxTransitionAttempts is number of times a transition is retried when the status changes concurrently
+const maxTransitionAttempts = 3
+
+// TransitionStatus moves the transaction to a new status and records the transition.
+//
+// The update only succeeds if the status in database is still the one that was validated, hence
+// concurrent writers cannot skip the transition table. On success, db holds the saved transaction.
+func TransitionStatus(sqlDB *gorm.DB, db *STKTransaction, t *StatusTransition) error {
+	for attempt := 0; ; attempt++ {
+		from := StatusOf(db)
+		if !t.Correction && !CanTransition(from, t.To) {
+			return fmt.Errorf("%w: %s to %s", ErrIllegalTransition, from, t.To)
+		}
+
+		updates := make(map[string]interface{}, len(t.Updates)+1)
+		for k, v := range t.Updates {
+			updates[k] = v
+		}
+		updates["stk_status"] = t.To.String()
+
+		var applied bool
+
+		err := sqlDB.Transaction(func(tx *gorm.DB) error {
+			res := tx.Model(&STKTransaction{}).Where("id = ? AND stk_status <=> ?", db.ID, db.StkStatus).Updates(updates)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return nil
+			}
+			applied = true
+			err := tx.Create(newEvent(db.ID, from, t)).Error
+			if err != nil {
+				return err
+			}
+			return runHooks(tx, db.ID, t.Hooks)
+		})
+		if err != nil {
+			return err
+		}
+
+		// Read the latest state either to return it or to validate again
+		err = sqlDB.First(db, "id = ?", db.ID).Error
+		if err != nil {
+			return err
+		}
+
+		if applied {
+			return nil
+		}
+
+		if attempt+1 >= maxTransitionAttempts {
+			return fmt.Errorf("stk transaction %d status changed concurrently", db.ID)
+		}
+	}
+}
+
+// RecordEvent records a change to the transaction that leaves its status as is, e.g. processed state
+func RecordEvent(sqlDB *gorm.DB, db *STKTransaction, source stk.StkEventSource, description, actorID string) error {
+	status := StatusOf(db)
+	return sqlDB.Create(newEvent(db.ID, status, &StatusTransition{
+		To:          status,
+		Source:      source,
+		Description: description,
+		ActorID:     actorID,
+	})).Error
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+func (stkAPI *stkAPIServer) ListStkTransactionEvents(
+	ctx context.Context, req *stk.ListStkTransactionEventsRequest,
+) (*stk.ListStkTransactionEventsResponse, error) {
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	case req.TransactionId == 0:
+		return nil, errs.MissingField("transaction id")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	db := &STKTransaction{}
+	err = stkAPI.SQLDB.First(db, "id=?", req.TransactionId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("stk transaction", fmt.Sprint(req.TransactionId))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+
+	allowedPhones, err := stkAPI.allowedPhones(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allowedPhones) > 0 && !containsString(allowedPhones, db.PhoneNumber) {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "not allowed to view stk transaction")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	dbs := make([]*STKTransactionEvent, 0, pageSize+1)
+
+	err = stkAPI.SQLDB.Where("transaction_id = ? AND id > ?", req.TransactionId, id).Order("id ASC").
+		Limit(int(pageSize) + 1).Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list stk transaction events")
+	}
+
+	pbs := make([]*stk.StkTransactionEvent, 0, len(dbs))
+
+	for i, db := range dbs {
+		if i == int(pageSize) {
+			break
+		}
+
+		pb, err := EventToProto(db)
+		if err != nil {
+			return nil, err
+		}
+
+		pbs = append(pbs, pb)
+		id = uint64(db.ID)
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(id)))
+	}
+
+	return &stk.ListStkTransactionEventsResponse{
+		NextPageToken: token,
+		Events:        pbs,
+	}, nil
+}
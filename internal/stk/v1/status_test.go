@@ -0,0 +1,61 @@
+package stk
+
+import (
+	"testing"
+
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+)
+
+func TestIsFinalStatus(t *testing.T) {
+	tests := []struct {
+		status stk.StkStatus
+		final  bool
+	}{
+		{status: stk.StkStatus_STK_STATUS_UNKNOWN},
+		{status: stk.StkStatus_STK_REQUEST_SUBMITED},
+		{status: stk.StkStatus_STK_REQUEST_SUCCESS},
+		// Callbacks may still replace request failures and query results
+		{status: stk.StkStatus_STK_REQUEST_FAILED},
+		{status: stk.StkStatus_STK_RESULT_SUCCESS},
+		{status: stk.StkStatus_STK_RESULT_FAILED},
+		{status: stk.StkStatus_STK_SUCCESS, final: true},
+		{status: stk.StkStatus_STK_FAILED, final: true},
+	}
+
+	for _, tt := range tests {
+		if got := IsFinalStatus(tt.status); got != tt.final {
+			t.Errorf("IsFinalStatus(%s) = %v, want %v", tt.status, got, tt.final)
+		}
+	}
+}
+
+func TestQuerySuccessIsNotReplacedByFailure(t *testing.T) {
+	if CanTransition(stk.StkStatus_STK_RESULT_SUCCESS, stk.StkStatus_STK_FAILED) {
+		t.Error("query success can move to failure")
+	}
+	if !CanTransition(stk.StkStatus_STK_RESULT_SUCCESS, stk.StkStatus_STK_SUCCESS) {
+		t.Error("query success cannot be confirmed by callback")
+	}
+}
+
+func TestIsSettledStatus(t *testing.T) {
+	tests := []struct {
+		status  stk.StkStatus
+		settled bool
+	}{
+		{status: stk.StkStatus_STK_STATUS_UNKNOWN},
+		{status: stk.StkStatus_STK_REQUEST_SUBMITED},
+		{status: stk.StkStatus_STK_REQUEST_SUCCESS},
+		{status: stk.StkStatus_STK_REQUEST_FAILED, settled: true},
+		{status: stk.StkStatus_STK_RESULT_SUCCESS, settled: true},
+		{status: stk.StkStatus_STK_RESULT_FAILED, settled: true},
+		{status: stk.StkStatus_STK_SUCCESS, settled: true},
+		{status: stk.StkStatus_STK_FAILED, settled: true},
+	}
+
+	for _, tt := range tests {
+		if got := IsSettledStatus(tt.status); got != tt.settled {
+			t.Errorf("IsSettledStatus(%s) = %v, want %v", tt.status, got, tt.settled)
+		}
+	}
+}
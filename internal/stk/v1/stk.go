@@ -1,26 +1,28 @@
 package stk
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
 	"github.com/gidyon/gomicro/utils/errs"
 	"github.com/gidyon/mpesapayments/pkg/utils/formatutil"
-	"github.com/gidyon/mpesapayments/pkg/utils/httputils"
 	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
 	redis "github.com/go-redis/redis/v8"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -35,6 +37,14 @@ type HTTPClient interface {
 type stkAPIServer struct {
 	stk.UnsafeStkPushV1Server
 	*Options
+	credentials *CredentialRegistry
+	updates     *updatesHub
+	outboxWake  chan struct{}
+	exportWake  chan struct{}
+	// pageTokenKey signs page tokens
+	pageTokenKey []byte
+	// exportURLKey signs download links of export files
+	exportURLKey []byte
 }
 
 // Options contain parameters passed for creating stk service
@@ -49,6 +59,34 @@ type Options struct {
 	AllowQueryStatus          bool
 	SystemIdPrefix            string
 	PublishProcessChannel     string
+	Credentials               []*ShortCodeCredential
+	LoadCredentialsFromDB     bool
+	NowFunc                   func() time.Time
+	AccessTokenProvider       AccessTokenProvider
+	IdempotencyWindow         time.Duration
+	CallbackTokenEnabled      bool
+	CrossCheckCallbacks       bool
+	WebhookSecret             string
+	WebhookMaxAttempts        int
+	// WebhookAllowedHosts restricts hosts of webhook urls when not empty
+	WebhookAllowedHosts  []string
+	OutboxStuckAfter     time.Duration
+	PublishMode          string
+	StreamMaxLen         int64
+	Publisher            Publisher
+	EventFormat          string
+	EventSource          string
+	OutboxMaxAttempts    int
+	ProcessMaxDeliveries int
+	ProcessReplyChannel  string
+	PageTokenSecret      string
+	ExportStore          BlobStore
+	ExportDownloadURL    string
+	ExportURLExpiry      time.Duration
+	ExportSigningSecret  string
+	ExportJobsChannel    string
+	// ExportRetention is how long files of finished export jobs are kept
+	ExportRetention time.Duration
 }
 
 // ValidateOptions validates options required by stk service
@@ -69,6 +107,10 @@ func ValidateOptions(opt *Options) error {
 		err = errs.MissingField("http client")
 	case opt.OptionSTK == nil:
 		err = errs.MissingField("stk options")
+	case !validPublishMode(opt.PublishMode):
+		err = errs.IncorrectVal("publish mode")
+	case !validEventFormat(opt.EventFormat):
+		err = errs.IncorrectVal("event format")
 	}
 	return err
 }
@@ -81,13 +123,9 @@ type OptionSTK struct {
 	ConsumerSecret    string
 	BusinessShortCode string
 	AccountReference  string
-	Timestamp         string
 	CallBackURL       string
 	PostURL           string
 	QueryURL          string
-	password          string
-	accessToken       string
-	basicToken        string
 }
 
 // ValidateOptionSTK validates stk options
@@ -106,23 +144,18 @@ func ValidateOptionSTK(opt *OptionSTK) error {
 		err = errs.MissingField("business short code")
 	case opt.AccountReference == "":
 		err = errs.MissingField("account reference")
-	case opt.Timestamp == "":
-		err = errs.MissingField("timestamp")
 	case opt.PassKey == "":
 		err = errs.MissingField("pass key")
 	case opt.CallBackURL == "":
 		err = errs.MissingField("callback url")
 	case opt.PostURL == "":
 		err = errs.MissingField("post url")
-	case opt.QueryURL == "":
-	case opt.accessToken == "":
-	case opt.basicToken == "":
 	}
 	return err
 }
 
 // NewStkAPI creates a singleton instance of mpesa stk API
-func NewStkAPI(ctx context.Context, opt *Options) (_ stk.StkPushV1Server, err error) {
+func NewStkAPI(ctx context.Context, opt *Options) (_ APIServer, err error) {
 
 	defer func() {
 		if err != nil {
@@ -145,19 +178,79 @@ func NewStkAPI(ctx context.Context, opt *Options) (_ stk.StkPushV1Server, err er
 		}
 	}
 
-	// Update Basic Token
-	opt.OptionSTK.basicToken = base64.StdEncoding.EncodeToString([]byte(
-		opt.OptionSTK.ConsumerKey + ":" + opt.OptionSTK.ConsumerSecret,
-	))
+	if opt.NowFunc == nil {
+		opt.NowFunc = time.Now
+	}
+
+	if opt.Publisher == nil {
+		opt.Publisher, err = defaultPublisher(opt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opt.ExportStore == nil {
+		opt.ExportStore, err = NewLocalBlobStore(filepath.Join(os.TempDir(), defaultExportDir))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opt.IdempotencyWindow <= 0 {
+		opt.IdempotencyWindow = 24 * time.Hour
+	}
 
-	// Update Password
-	opt.OptionSTK.password = base64.StdEncoding.EncodeToString([]byte(
-		opt.OptionSTK.BusinessShortCode + opt.OptionSTK.PassKey + opt.OptionSTK.Timestamp,
-	))
+	if opt.ExportRetention <= 0 {
+		opt.ExportRetention = defaultExportRetention
+	}
+
+	// Access tokens shared across replicas
+	if opt.AccessTokenProvider == nil {
+		opt.AccessTokenProvider, err = NewRedisTokenProvider(&RedisTokenProviderOptions{
+			RedisDB:        opt.RedisDB,
+			HTTPClient:     opt.HTTPClient,
+			Logger:         opt.Logger,
+			AccessTokenURL: opt.OptionSTK.AccessTokenURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Credentials for the default shortcode come first
+	credentials, err := NewCredentialRegistry(append([]*ShortCodeCredential{{
+		ShortCode:        opt.OptionSTK.BusinessShortCode,
+		PassKey:          opt.OptionSTK.PassKey,
+		ConsumerKey:      opt.OptionSTK.ConsumerKey,
+		ConsumerSecret:   opt.OptionSTK.ConsumerSecret,
+		CallBackURL:      opt.OptionSTK.CallBackURL,
+		AccountReference: opt.OptionSTK.AccountReference,
+	}}, opt.Credentials...)...)
+	if err != nil {
+		return nil, err
+	}
 
 	// API server
 	stkAPI := &stkAPIServer{
-		Options: opt,
+		Options:     opt,
+		credentials: credentials,
+		updates:     newUpdatesHub(),
+		outboxWake:  make(chan struct{}, 1),
+		exportWake:  make(chan struct{}, 1),
+	}
+
+	if opt.PageTokenSecret != "" {
+		stkAPI.pageTokenKey = []byte(opt.PageTokenSecret)
+	} else {
+		stkAPI.Logger.Warningln("page token secret not set; page tokens will not survive restarts")
+		stkAPI.pageTokenKey = []byte(randomHex(32))
+	}
+
+	if opt.ExportSigningSecret != "" {
+		stkAPI.exportURLKey = []byte(opt.ExportSigningSecret)
+	} else {
+		stkAPI.Logger.Warningln("export signing secret not set; export download links will not survive restarts")
+		stkAPI.exportURLKey = []byte(randomHex(32))
 	}
 
 	// Auto migration
@@ -168,7 +261,66 @@ func NewStkAPI(ctx context.Context, opt *Options) (_ stk.StkPushV1Server, err er
 		}
 	}
 
-	dur := time.Minute * 15
+	err = migrateColumns(stkAPI.SQLDB, &STKTransaction{}, "TransactionType", "IdempotencyKey", "Balance", "CallbackExtras", "CallbackTokenHash")
+	if err != nil {
+		return nil, err
+	}
+
+	// Idempotency keys were indexed without a unique constraint before
+	err = dropNonUniqueIndex(stkAPI.SQLDB, &STKTransaction{}, "idx_initiator_idempotency_key")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range []string{
+		"idx_initiator_idempotency_key", "idx_amount", "idx_initiator_customer_names", "ft_initiator_customer_names", "ft_transaction_desc",
+	} {
+		if !stkAPI.SQLDB.Migrator().HasIndex(&STKTransaction{}, index) {
+			err = stkAPI.SQLDB.Migrator().CreateIndex(&STKTransaction{}, index)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !stkAPI.SQLDB.Migrator().HasTable(&STKTransactionEvent{}) {
+		err = stkAPI.SQLDB.Migrator().AutoMigrate(&STKTransactionEvent{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !stkAPI.SQLDB.Migrator().HasTable(&STKCallback{}) {
+		err = stkAPI.SQLDB.Migrator().AutoMigrate(&STKCallback{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, model := range []interface{}{&STKInitiatorWebhook{}, &STKWebhookDelivery{}, &STKWebhookAttempt{}, &STKOutboxEntry{}, &STKDeadLetter{}, &STKExportJob{}, &STKReconciliation{}, &STKReconciliationItem{}} {
+		if !stkAPI.SQLDB.Migrator().HasTable(model) {
+			err = stkAPI.SQLDB.Migrator().AutoMigrate(model)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if opt.LoadCredentialsFromDB {
+		if !stkAPI.SQLDB.Migrator().HasTable(&ShortCodeCredential{}) {
+			err = stkAPI.SQLDB.Migrator().AutoMigrate(&ShortCodeCredential{})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = stkAPI.credentials.LoadFromDB(stkAPI.SQLDB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dur := time.Minute
 	if opt.UpdateAccessTokenDuration > 0 {
 		dur = opt.UpdateAccessTokenDuration
 	}
@@ -185,6 +337,18 @@ func NewStkAPI(ctx context.Context, opt *Options) (_ stk.StkPushV1Server, err er
 		go stkAPI.processWorker(ctx)
 	}
 
+	// Worker for waking up waiters of stk results
+	go stkAPI.transactionUpdatesWorker(ctx)
+
+	// Worker for retrying webhook deliveries
+	go stkAPI.webhookWorker(ctx)
+
+	// Worker for dispatching outbox entries
+	go stkAPI.outboxWorker(ctx)
+
+	// Worker for running export jobs
+	go stkAPI.exportJobWorker(ctx)
+
 	return stkAPI, nil
 }
 
@@ -198,6 +362,14 @@ func GetMpesaRequestKey(requestId string) string {
 	return fmt.Sprintf("stk:%s", requestId)
 }
 
+// eatLocation is East Africa Time which daraja expects timestamps to be in
+var eatLocation = time.FixedZone("EAT", 3*60*60)
+
+// timestamp returns the current daraja request timestamp in format YYYYMMDDHHmmss
+func (stkAPI *stkAPIServer) timestamp() string {
+	return stkAPI.NowFunc().In(eatLocation).Format("20060102150405")
+}
+
 func firstVal(A ...string) string {
 	for _, s := range A {
 		if s != "" {
@@ -224,28 +396,69 @@ func (stkAPI *stkAPIServer) InitiateSTK(
 		return nil, errs.MissingField("amount")
 	case req.Publish && req.GetPublishMessage().GetChannelName() == "":
 		return nil, errs.MissingField("publisch channel")
+	case len(req.IdempotencyKey) > 100:
+		return nil, errs.IncorrectVal("idempotency key")
+	}
+
+	if webhookURL := req.GetPublishMessage().GetWebhookUrl(); webhookURL != "" {
+		err := stkAPI.validateWebhookURL(webhookURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Credentials for the shortcode
+	cred, err := stkAPI.credentials.Get(req.ShortCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transaction type in request takes precedence
+	txType := req.TransactionType
+	if txType == stk.StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED {
+		txType = cred.transactionType()
+	}
+
+	// Till number receives buy goods payments while the shortcode is the store number
+	partyB := cred.ShortCode
+	if txType == stk.StkTransactionType_CUSTOMER_BUY_GOODS_ONLINE {
+		if cred.TillNumber == "" {
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "short code %s has no till number for buy goods", cred.ShortCode)
+		}
+		partyB = cred.TillNumber
 	}
 
 	var (
 		phoneNumber = formatutil.FormatPhoneKE(req.Phone)
-		shortCode   = firstVal(req.ShortCode, stkAPI.OptionSTK.BusinessShortCode)
-		accountRef  = firstVal(req.AccountReference, stkAPI.OptionSTK.AccountReference)
+		shortCode   = cred.ShortCode
+		accountRef  = firstVal(req.AccountReference, cred.AccountReference, stkAPI.OptionSTK.AccountReference)
+		timestamp   = stkAPI.timestamp()
 		pb          = &STKRequestBody{
 			BusinessShortCode: shortCode,
-			Password:          stkAPI.OptionSTK.password,
-			Timestamp:         stkAPI.OptionSTK.Timestamp,
-			TransactionType:   "CustomerPayBillOnline",
+			Password:          cred.password(timestamp),
+			Timestamp:         timestamp,
+			TransactionType:   DarajaTransactionType(txType),
 			Amount:            fmt.Sprint(req.Amount),
 			PartyA:            phoneNumber,
-			PartyB:            shortCode,
+			PartyB:            partyB,
 			PhoneNumber:       phoneNumber,
-			CallBackURL:       stkAPI.OptionSTK.CallBackURL,
+			CallBackURL:       firstVal(cred.CallBackURL, stkAPI.OptionSTK.CallBackURL),
 			AccountReference:  accountRef,
 			TransactionDesc:   firstVal(req.TransactionDesc, "NA"),
 		}
-		err error
 	)
 
+	// Secret token in callback url proves that the callback is for this transaction
+	var callbackTokenHash sql.NullString
+	if stkAPI.CallbackTokenEnabled {
+		token := randomHex(16)
+		pb.CallBackURL, err = withCallbackToken(pb.CallBackURL, token)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to set callback url")
+		}
+		callbackTokenHash = sql.NullString{String: hashCallbackToken(token), Valid: true}
+	}
+
 	if req.PublishMessage == nil {
 		req.PublishMessage = &stk.PublishInfo{
 			Payload: map[string]string{},
@@ -257,6 +470,7 @@ func (stkAPI *stkAPIServer) InitiateSTK(
 	}
 
 	req.PublishMessage.Payload["short_code"] = shortCode
+	req.TransactionType = txType
 
 	// Marshal request
 	bs, err := json.Marshal(pb)
@@ -264,18 +478,6 @@ func (stkAPI *stkAPIServer) InitiateSTK(
 		return nil, errs.FromJSONMarshal(err, "pb")
 	}
 
-	// Create Mpesa STK request
-	reqHtpp, err := http.NewRequest(http.MethodPost, stkAPI.OptionSTK.PostURL, bytes.NewReader(bs))
-	if err != nil {
-		return nil, errs.WrapMessage(codes.Internal, "failed to create post stk request")
-	}
-
-	// Update headers
-	reqHtpp.Header.Set("Authorization", fmt.Sprintf("Bearer %s", stkAPI.OptionSTK.accessToken))
-	reqHtpp.Header.Set("Content-Type", "application/json")
-
-	httputils.DumpRequest(reqHtpp, "INITIATE STK REQUEST")
-
 	// STK model
 	db := &STKTransaction{
 		ID:                         0,
@@ -288,106 +490,237 @@ func (stkAPI *stkAPIServer) InitiateSTK(
 		AccountReference:           accountRef,
 		TransactionDesc:            sql.NullString{String: req.TransactionDesc, Valid: true},
 		StkStatus:                  sql.NullString{String: stk.StkStatus_STK_REQUEST_SUBMITED.String(), Valid: true},
-		TransactionTime:            sql.NullTime{Valid: true, Time: time.Now().UTC()},
+		TransactionType:            sql.NullString{String: txType.String(), Valid: true},
+		IdempotencyKey:             sql.NullString{String: req.IdempotencyKey, Valid: req.IdempotencyKey != ""},
+		CallbackTokenHash:          callbackTokenHash,
+		TransactionTime:            sql.NullTime{Valid: true, Time: stkAPI.NowFunc().UTC()},
 		CreatedAt:                  time.Time{},
 	}
 
+	// Repeated requests return the original transaction
+	if req.IdempotencyKey != "" {
+		res, err := stkAPI.claimIdempotencyKey(ctx, req, db)
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return res, nil
+		}
+	}
+
 	// Save the request to database
-	err = stkAPI.SQLDB.Create(db).Error
-	if err != nil {
+	err = CreateTransaction(stkAPI.SQLDB, db, stk.StkEventSource_STK_SOURCE_INITIATE, "stk request submitted")
+	switch {
+	case err == nil:
+	case req.IdempotencyKey != "" && isDuplicateKey(err):
+		// The cache lost the key while a transaction with the key was being saved
+		return stkAPI.savedIdempotentRequest(ctx, req, db)
+	default:
+		if req.IdempotencyKey != "" {
+			stkAPI.releaseIdempotencyKey(ctx, req)
+		}
 		stkAPI.Logger.Errorln(err)
 		return nil, errs.WrapMessage(codes.Internal, "failed to save stk")
 	}
 
+	if req.IdempotencyKey != "" {
+		stkAPI.setIdempotencyKey(ctx, req, db.ID)
+	}
+
+	stkAPI.notifyUpdate(ctx, stk.StkTransactionEventType_STK_TRANSACTION_CREATED, db.ID)
+
+	// Synchronous requests wait for daraja acknowledgement
+	if req.Synchronous {
+		return stkAPI.sendSTKSync(ctx, cred, db, req, bs)
+	}
+
+	transactionID := uint64(db.ID)
+
 	go func() {
-		err := func() error {
-			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-			defer cancel()
+		ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+		defer cancel()
 
-			res, err := stkAPI.HTTPClient.Do(reqHtpp)
-			if err != nil {
-				return fmt.Errorf("failed to post stk request to mpesa API: %v", err)
-			}
+		_ = stkAPI.sendSTK(ctx, cred, db, req, bs)
+	}()
 
-			httputils.DumpResponse(res, "INITIATE STK RESPONSE")
+	return &stk.InitiateSTKResponse{
+		Progress:      true,
+		Message:       "Processing. Stk popup will come shortly",
+		TransactionId: transactionID,
+	}, nil
+}
 
-			resData := make(map[string]interface{})
+const (
+	sendTimeout        = 15 * time.Second
+	defaultSyncTimeout = 20 * time.Second
+	maxSyncTimeout     = time.Minute
+)
 
-			err = json.NewDecoder(res.Body).Decode(&resData)
-			if err != nil && err != io.EOF {
-				return fmt.Errorf("failed to decode mpesa response: %v", err)
-			}
+// sendSTKSync sends stk push and waits for daraja response until the timeout elapses
+func (stkAPI *stkAPIServer) sendSTKSync(
+	ctx context.Context, cred *ShortCodeCredential, db *STKTransaction, req *stk.InitiateSTKRequest, body []byte,
+) (*stk.InitiateSTKResponse, error) {
+	transactionID := db.ID
 
-			errMsg, ok := resData["errorMessage"]
-			if ok {
-				return fmt.Errorf("error happened while sending stk push: %v", errMsg)
-			}
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	switch {
+	case timeout <= 0:
+		timeout = defaultSyncTimeout
+	case timeout > maxSyncTimeout:
+		timeout = maxSyncTimeout
+	}
 
-			switch strings.ToLower(res.Header.Get("content-type")) {
-			case "application/json", "application/json;charset=utf-8":
-				// The CheckoutRequestID must exist
-				_, ok := resData["CheckoutRequestID"].(string)
-				if !ok {
-					return errors.New("stk request failed: missing CheckoutRequestID")
-				}
+	// Sending is not tied to the caller so that the outcome is saved even if caller goes away
+	done := make(chan error, 1)
 
-				// Update STK
-				err = stkAPI.SQLDB.Model(db).Updates(&STKTransaction{
-					MerchantRequestID:          sql.NullString{String: fmt.Sprint(resData["MerchantRequestID"]), Valid: fmt.Sprint(resData["MerchantRequestID"]) != ""},
-					CheckoutRequestID:          sql.NullString{String: fmt.Sprint(resData["CheckoutRequestID"]), Valid: fmt.Sprint(resData["CheckoutRequestID"]) != ""},
-					StkResponseDescription:     sql.NullString{String: fmt.Sprint(resData["ResponseDescription"]), Valid: fmt.Sprint(resData["ResponseDescription"]) != ""},
-					StkResponseCustomerMessage: sql.NullString{String: fmt.Sprint(resData["CustomerMessage"]), Valid: fmt.Sprint(resData["CustomerMessage"]) != ""},
-					StkResponseCode:            sql.NullString{String: fmt.Sprint(resData["ResponseCode"]), Valid: fmt.Sprint(resData["ResponseCode"]) != ""},
-					StkStatus:                  sql.NullString{String: stk.StkStatus_STK_REQUEST_SUCCESS.String(), Valid: true},
-					Succeeded:                  "NO",
-					Processed:                  "NO",
-					TransactionTime:            sql.NullTime{Valid: true, Time: time.Now().UTC()},
-					CreatedAt:                  time.Time{},
-				}).Error
-				if err != nil {
-					stkAPI.Logger.Errorln(err)
-					return errors.New("failed to update stk payload")
-				}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), sendTimeout+timeout)
+		defer cancel()
 
-				// Marshal request
-				bs, err := proto.Marshal(req)
-				if err != nil {
-					return fmt.Errorf("failed to proto marshal initiate stk request: %v", err)
-				}
+		done <- stkAPI.sendSTK(ctx, cred, db, req, body)
+	}()
 
-				requestId := GetMpesaRequestKey(fmt.Sprint(resData["CheckoutRequestID"]))
+	var (
+		sendErr  error
+		finished bool
+	)
 
-				// Save request to cache
-				err = stkAPI.RedisDB.Set(ctx, requestId, bs, time.Minute*15).Err()
-				if err != nil {
-					return fmt.Errorf("failed to set initiate stk request to cache: %v", err)
-				}
-			default:
-				return errors.New("incorrect response while initiating STK")
-			}
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case sendErr = <-done:
+		finished = true
+	case <-time.After(timeout):
+	}
 
-			return nil
-		}()
+	// Read the persisted state of the transaction
+	saved := &STKTransaction{}
+	err := stkAPI.SQLDB.First(saved, "id = ?", transactionID).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+	}
+
+	pb, err := ToProto(saved)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &stk.InitiateSTKResponse{
+		Progress:       true,
+		Message:        "Stk request accepted. Stk popup will come shortly",
+		TransactionId:  pb.TransactionId,
+		StkTransaction: pb,
+	}
+
+	switch {
+	case !finished:
+		res.Message = "Processing. Timed out waiting for stk acknowledgement"
+	case sendErr != nil:
+		res.Progress = false
+		res.Message = sendErr.Error()
+	}
+
+	return res, nil
+}
+
+// sendSTK posts the stk push request to daraja and saves the acknowledgement or the failure
+func (stkAPI *stkAPIServer) sendSTK(
+	ctx context.Context, cred *ShortCodeCredential, db *STKTransaction, req *stk.InitiateSTKRequest, bs []byte,
+) error {
+	err := func() error {
+		res, err := stkAPI.postDaraja(ctx, cred, stkAPI.OptionSTK.PostURL, bs, "INITIATE STK")
 		if err != nil {
-			// Update status to failed
-			err = stkAPI.SQLDB.Model(db).Updates(&STKTransaction{
-				StkResponseDescription: sql.NullString{String: err.Error(), Valid: true},
-				StkStatus:              sql.NullString{String: stk.StkStatus_STK_REQUEST_FAILED.String(), Valid: true},
-				Succeeded:              "NO",
-				Processed:              "NO",
-				TransactionTime:        sql.NullTime{Valid: true, Time: time.Now().UTC()},
-				CreatedAt:              time.Time{},
-			}).Error
+			return fmt.Errorf("failed to post stk request to mpesa API: %v", err)
+		}
+		defer res.Body.Close()
+
+		resData := make(map[string]interface{})
+
+		err = json.NewDecoder(res.Body).Decode(&resData)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("failed to decode mpesa response: %v", err)
+		}
+
+		errMsg, ok := resData["errorMessage"]
+		if ok {
+			return fmt.Errorf("error happened while sending stk push: %v", errMsg)
+		}
+
+		switch strings.ToLower(res.Header.Get("content-type")) {
+		case "application/json", "application/json;charset=utf-8":
+			// The CheckoutRequestID must exist
+			_, ok := resData["CheckoutRequestID"].(string)
+			if !ok {
+				return errors.New("stk request failed: missing CheckoutRequestID")
+			}
+
+			// Marshal request
+			bs, err := proto.Marshal(req)
 			if err != nil {
+				return fmt.Errorf("failed to proto marshal initiate stk request: %v", err)
+			}
+
+			requestId := GetMpesaRequestKey(fmt.Sprint(resData["CheckoutRequestID"]))
+
+			// Save request to cache before the callback can arrive
+			err = stkAPI.RedisDB.Set(ctx, requestId, bs, time.Minute*15).Err()
+			if err != nil {
+				return fmt.Errorf("failed to set initiate stk request to cache: %v", err)
+			}
+
+			// Update STK
+			err = TransitionStatus(stkAPI.SQLDB, db, &StatusTransition{
+				To:          stk.StkStatus_STK_REQUEST_SUCCESS,
+				Source:      stk.StkEventSource_STK_SOURCE_INITIATE,
+				Description: fmt.Sprint(resData["ResponseDescription"]),
+				Updates: map[string]interface{}{
+					"merchant_request_id":           sql.NullString{String: fmt.Sprint(resData["MerchantRequestID"]), Valid: fmt.Sprint(resData["MerchantRequestID"]) != ""},
+					"checkout_request_id":           sql.NullString{String: fmt.Sprint(resData["CheckoutRequestID"]), Valid: fmt.Sprint(resData["CheckoutRequestID"]) != ""},
+					"stk_response_description":      sql.NullString{String: fmt.Sprint(resData["ResponseDescription"]), Valid: fmt.Sprint(resData["ResponseDescription"]) != ""},
+					"stk_response_customer_message": sql.NullString{String: fmt.Sprint(resData["CustomerMessage"]), Valid: fmt.Sprint(resData["CustomerMessage"]) != ""},
+					"stk_response_code":             sql.NullString{String: fmt.Sprint(resData["ResponseCode"]), Valid: fmt.Sprint(resData["ResponseCode"]) != ""},
+					"transaction_time":              sql.NullTime{Valid: true, Time: stkAPI.NowFunc().UTC()},
+				},
+			})
+			switch {
+			case err == nil:
+			case errors.Is(err, ErrIllegalTransition):
+				// Callback arrived before the acknowledgement was saved; only record the checkout id
+				err = stkAPI.SQLDB.Model(&STKTransaction{}).Where("id = ? AND checkout_request_id IS NULL", db.ID).
+					Update("checkout_request_id", fmt.Sprint(resData["CheckoutRequestID"])).Error
+				if err != nil {
+					stkAPI.Logger.Errorln(err)
+				}
+			default:
 				stkAPI.Logger.Errorln(err)
+				return errors.New("failed to update stk payload")
 			}
+		default:
+			return errors.New("incorrect response while initiating STK")
 		}
+
+		return nil
 	}()
+	if err != nil {
+		// Update status to failed unless the outcome is already known
+		errUpdate := TransitionStatus(stkAPI.SQLDB, db, &StatusTransition{
+			To:          stk.StkStatus_STK_REQUEST_FAILED,
+			Source:      stk.StkEventSource_STK_SOURCE_INITIATE,
+			Description: err.Error(),
+			Updates: map[string]interface{}{
+				"stk_response_description": sql.NullString{String: truncate(err.Error(), 300), Valid: true},
+				"transaction_time":         sql.NullTime{Valid: true, Time: stkAPI.NowFunc().UTC()},
+			},
+		})
+		if errUpdate != nil {
+			stkAPI.Logger.Errorln(errUpdate)
+		}
+	}
 
-	return &stk.InitiateSTKResponse{
-		Progress: true,
-		Message:  "Processing. Stk popup will come shortly",
-	}, nil
+	stkAPI.notifyUpdate(ctx, stk.StkTransactionEventType_STK_TRANSACTION_UPDATED, db.ID)
+
+	return err
 }
 
 func (stkAPI *stkAPIServer) GetStkTransaction(
@@ -423,12 +756,99 @@ func (stkAPI *stkAPIServer) GetStkTransaction(
 	return ToProto(db)
 }
 
+const (
+	defaultWaitTimeout = 30 * time.Second
+	maxWaitTimeout     = 2 * time.Minute
+	waitPollInterval   = 5 * time.Second
+)
+
+func (stkAPI *stkAPIServer) WaitStkResult(
+	ctx context.Context, req *stk.WaitStkResultRequest,
+) (*stk.StkTransaction, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request")
+	case req.TransactionId == 0:
+		return nil, errs.MissingField("transaction id")
+	}
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	switch {
+	case timeout <= 0:
+		timeout = defaultWaitTimeout
+	case timeout > maxWaitTimeout:
+		timeout = maxWaitTimeout
+	}
+
+	return stkAPI.waitSettled(ctx, req.TransactionId, timeout, func() (*stk.StkTransaction, error) {
+		db := &STKTransaction{}
+		err := stkAPI.SQLDB.First(db, "id=?", req.TransactionId).Error
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, status.Errorf(codes.NotFound, "stk transaction with id %d does not exist", req.TransactionId)
+		default:
+			stkAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to get stk transaction")
+		}
+		return ToProto(db)
+	})
+}
+
+// waitSettled loads the transaction until it is settled, the timeout elapses or the context is done
+func (stkAPI *stkAPIServer) waitSettled(
+	ctx context.Context, transactionID uint64, timeout time.Duration, load func() (*stk.StkTransaction, error),
+) (*stk.StkTransaction, error) {
+	// Subscribe before reading so that no update is missed
+	updated, unsubscribe := stkAPI.updates.subscribe(transactionID)
+	defer unsubscribe()
+
+	var (
+		deadline = time.NewTimer(timeout)
+		poll     = time.NewTicker(waitPollInterval)
+	)
+	defer deadline.Stop()
+	defer poll.Stop()
+
+	for {
+		pb, err := load()
+		if err != nil {
+			return nil, err
+		}
+
+		if IsSettledStatus(pb.Status) {
+			return pb, nil
+		}
+
+		// Wait for update, periodic poll or timeout
+		select {
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-deadline.C:
+			return pb, nil
+		case <-updated:
+		case <-poll.C:
+		}
+	}
+}
+
 const defaultPageSize = 100
 
 func userAllowedPhonesSet(userkey string) string {
 	return fmt.Sprintf("stk:user:%s:allowedphones", userkey)
 }
 
+// allowedPhones returns phone numbers the user is restricted to. Empty means no restriction.
+func (stkAPI *stkAPIServer) allowedPhones(ctx context.Context, userID string) ([]string, error) {
+	allowedPhones, err := stkAPI.RedisDB.SMembers(ctx, userAllowedPhonesSet(userID)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "request failed")
+	}
+	return allowedPhones, nil
+}
+
 func (stkAPI *stkAPIServer) ListStkTransactions(
 	ctx context.Context, req *stk.ListStkTransactionsRequest,
 ) (*stk.ListStkTransactionsResponse, error) {
@@ -447,13 +867,9 @@ func (stkAPI *stkAPIServer) ListStkTransactions(
 	}
 
 	// Read from redis list of phone numbers
-	var allowedPhones []string
-	allowedPhones, err = stkAPI.RedisDB.SMembers(
-		ctx, userAllowedPhonesSet(actor.ID),
-	).Result()
-	if err != nil && !errors.Is(err, redis.Nil) {
-		stkAPI.Logger.Errorln(err)
-		return nil, errs.WrapMessage(codes.Internal, "request failed")
+	allowedPhones, err := stkAPI.allowedPhones(ctx, actor.ID)
+	if err != nil {
+		return nil, err
 	}
 
 	pageSize := req.GetPageSize()
@@ -466,115 +882,53 @@ func (stkAPI *stkAPIServer) ListStkTransactions(
 		}
 	}
 
-	var key string
+	filter := req.GetFilter()
+
+	var cursor *transactionsCursor
 
 	pageToken := req.GetPageToken()
 	if pageToken != "" {
-		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		cursor, err = stkAPI.decodeCursor(pageToken, filter)
 		if err != nil {
-			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+			return nil, err
 		}
-		key = string(bs)
 	}
 
-	dbs := make([]*STKTransaction, 0, pageSize+1)
-
-	db := stkAPI.SQLDB.Model(&STKTransaction{}).Limit(int(pageSize) + 1)
-	if key != "" {
-		switch req.GetFilter().GetOrderField() {
-		case stk.StkOrderField_CREATE_TIMESTAMP:
-			db = db.Where("id<?", key).Order("id DESC")
-		case stk.StkOrderField_TRANSACTION_TIMESTAMP:
-			db = db.Where("transaction_time<?", key).Order("transaction_time DESC")
-		}
-	} else {
-		switch req.GetFilter().GetOrderField() {
-		case stk.StkOrderField_CREATE_TIMESTAMP:
-			db = db.Order("id DESC")
-		case stk.StkOrderField_TRANSACTION_TIMESTAMP:
-			db = db.Order("transaction_time DESC")
-		}
+	hash, err := filterHash(filter)
+	if err != nil {
+		return nil, err
 	}
 
+	db := stkAPI.SQLDB.Model(&STKTransaction{})
+
 	if len(allowedPhones) > 0 {
 		db = db.Where("phone_number IN(?)", allowedPhones)
 	}
 
 	// Apply filters
-	if req.Filter != nil {
-		startTimestamp := req.Filter.GetStartTimestamp()
-		endTimestamp := req.Filter.GetEndTimestamp()
-
-		// Timestamp filter
-		if endTimestamp > startTimestamp {
-			switch req.GetFilter().GetOrderField() {
-			case stk.StkOrderField_CREATE_TIMESTAMP:
-				db = db.Where("created_at BETWEEN ? AND ?", time.Unix(startTimestamp, 0), time.Unix(endTimestamp, 0))
-			case stk.StkOrderField_TRANSACTION_TIMESTAMP:
-				db = db.Where("transaction_time BETWEEN ? AND ?", time.Unix(startTimestamp, 0), time.Unix(endTimestamp, 0))
-			}
-		} else if req.Filter.TxDate != "" {
-			// Date filter
-			t, err := getTime(req.Filter.TxDate)
-			if err != nil {
-				return nil, err
-			}
-			switch req.GetFilter().GetOrderField() {
-			case stk.StkOrderField_CREATE_TIMESTAMP:
-				db = db.Where("created_at BETWEEN ? AND ?", t, t.Add(time.Hour*24))
-			case stk.StkOrderField_TRANSACTION_TIMESTAMP:
-				db = db.Where("transaction_time BETWEEN ? AND ?", t, t.Add(time.Hour*24))
-			}
-		}
-
-		if len(req.Filter.Msisdns) > 0 {
-			db = db.Where("phone_number IN(?)", req.Filter.Msisdns)
-		}
-
-		if len(req.Filter.MpesaReceipts) > 0 {
-			db = db.Where("mpesa_receipt_id IN(?)", req.Filter.MpesaReceipts)
-		}
-
-		if len(req.Filter.InitiatorCustomerReferences) > 0 {
-			db = db.Where("initiator_customer_reference IN(?)", req.Filter.InitiatorCustomerReferences)
-		}
-
-		if len(req.Filter.InitiatorTransactionReferences) > 0 {
-			db = db.Where("initiator_transaction_reference IN(?)", req.Filter.InitiatorTransactionReferences)
-		}
-
-		if len(req.Filter.ShortCodes) > 0 {
-			db = db.Where("short_code IN(?)", req.Filter.ShortCodes)
-		}
-
-		if len(req.Filter.StkStatuses) > 0 {
-			ss := make([]string, 0, len(req.Filter.StkStatuses))
-			for _, s := range req.Filter.StkStatuses {
-				ss = append(ss, s.String())
-			}
-			db = db.Where("stk_status IN(?)", ss)
-		}
-
-		switch req.Filter.ProcessState {
-		case stk.StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED:
-		case stk.StkProcessedState_STK_NOT_PROCESSED:
-			db = db.Where("processed=?", "NO")
-		case stk.StkProcessedState_STK_PROCESSED:
-			db = db.Where("processed=?", "YES")
-		}
+	db, err = filterTransactions(db, filter)
+	if err != nil {
+		return nil, err
 	}
 
 	var collectionCount int64
 
 	if pageToken == "" && req.View == stk.ListStkTransactionsView_BASIC_VIEW {
-		err = db.Count(&collectionCount).Error
+		err = db.Session(&gorm.Session{}).Count(&collectionCount).Error
 		if err != nil {
 			stkAPI.Logger.Errorln(err)
 			return nil, errs.WrapMessage(codes.Internal, "request failed")
 		}
 	}
 
-	err = db.Find(&dbs).Error
+	db, err = keysetPage(db, filter, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	dbs := make([]*STKTransaction, 0, pageSize+1)
+
+	err = db.Limit(int(pageSize) + 1).Find(&dbs).Error
 	switch {
 	case err == nil:
 	default:
@@ -582,39 +936,231 @@ func (stkAPI *stkAPIServer) ListStkTransactions(
 		return nil, errs.WrapMessage(codes.Internal, "request failed")
 	}
 
+	var token string
+	if len(dbs) > int(pageSize) {
+		dbs = dbs[:pageSize]
+		// Next page token
+		token, err = stkAPI.encodeCursor(cursorAfter(dbs[len(dbs)-1], filter, hash))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	pbs := make([]*stk.StkTransaction, 0, len(dbs))
 
-	for i, db := range dbs {
+	for _, db := range dbs {
 		pb, err := ToProto(db)
 		if err != nil {
 			return nil, err
 		}
+		pbs = append(pbs, pb)
+	}
+
+	return &stk.ListStkTransactionsResponse{
+		NextPageToken:   token,
+		StkTransactions: pbs,
+		CollectionCount: collectionCount,
+	}, nil
+}
 
-		if i == int(pageSize) {
-			break
+// filterTransactions applies the list filter to transactions query
+func filterTransactions(db *gorm.DB, filter *stk.ListStkTransactionFilter) (*gorm.DB, error) {
+	if filter == nil {
+		return db, nil
+	}
+
+	_, col, _ := transactionsOrder(filter)
+
+	startTimestamp := filter.GetStartTimestamp()
+	endTimestamp := filter.GetEndTimestamp()
+
+	// Timestamp filter
+	if endTimestamp > startTimestamp {
+		db = db.Where(col+" BETWEEN ? AND ?", time.Unix(startTimestamp, 0), time.Unix(endTimestamp, 0))
+	} else if filter.TxDate != "" {
+		// Date filter
+		t, err := getTime(filter.TxDate)
+		if err != nil {
+			return nil, err
 		}
+		db = db.Where(col+" BETWEEN ? AND ?", t, t.Add(time.Hour*24))
+	}
 
-		pbs = append(pbs, pb)
+	if len(filter.Msisdns) > 0 {
+		db = db.Where("phone_number IN(?)", filter.Msisdns)
+	}
+
+	if len(filter.MpesaReceipts) > 0 {
+		db = db.Where("mpesa_receipt_id IN(?)", filter.MpesaReceipts)
+	}
+
+	if len(filter.InitiatorCustomerReferences) > 0 {
+		db = db.Where("initiator_customer_reference IN(?)", filter.InitiatorCustomerReferences)
+	}
 
-		switch req.GetFilter().GetOrderField() {
-		case stk.StkOrderField_CREATE_TIMESTAMP:
-			key = fmt.Sprint(db.ID)
-		case stk.StkOrderField_TRANSACTION_TIMESTAMP:
-			key = db.TransactionTime.Time.UTC().Format(time.RFC3339)
+	if len(filter.InitiatorTransactionReferences) > 0 {
+		db = db.Where("initiator_transaction_reference IN(?)", filter.InitiatorTransactionReferences)
+	}
+
+	if len(filter.ShortCodes) > 0 {
+		db = db.Where("short_code IN(?)", filter.ShortCodes)
+	}
+
+	if len(filter.StkStatuses) > 0 {
+		ss := make([]string, 0, len(filter.StkStatuses))
+		for _, s := range filter.StkStatuses {
+			ss = append(ss, s.String())
 		}
+		db = db.Where("stk_status IN(?)", ss)
 	}
 
-	var token string
-	if len(dbs) > int(pageSize) {
-		// Next page token
-		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(key)))
+	if len(filter.TransactionTypes) > 0 {
+		tts := make([]string, 0, len(filter.TransactionTypes))
+		for _, t := range filter.TransactionTypes {
+			tts = append(tts, t.String())
+		}
+		db = db.Where("transaction_type IN(?)", tts)
 	}
 
-	return &stk.ListStkTransactionsResponse{
-		NextPageToken:   token,
-		StkTransactions: pbs,
-		CollectionCount: collectionCount,
-	}, nil
+	switch filter.ProcessState {
+	case stk.StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED:
+	case stk.StkProcessedState_STK_NOT_PROCESSED:
+		db = db.Where("processed=?", "NO")
+	case stk.StkProcessedState_STK_PROCESSED:
+		db = db.Where("processed=?", "YES")
+	}
+
+	if len(filter.InitiatorIds) > 0 {
+		db = db.Where("initiator_id IN(?)", filter.InitiatorIds)
+	}
+
+	if len(filter.AccountReferences) > 0 {
+		db = db.Where("account_reference IN(?)", filter.AccountReferences)
+	}
+
+	switch filter.SucceededState {
+	case stk.StkSucceededState_STK_SUCCEEDED_STATE_UNSPECIFIED:
+	case stk.StkSucceededState_STK_NOT_SUCCEEDED:
+		db = db.Where("succeeded=?", "NO")
+	case stk.StkSucceededState_STK_SUCCEEDED:
+		db = db.Where("succeeded=?", "YES")
+	}
+
+	// Amount range
+	switch {
+	case filter.MinAmount < 0 || filter.MaxAmount < 0:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "amount range cannot be negative")
+	case filter.MaxAmount > 0 && filter.MinAmount > filter.MaxAmount:
+		return nil, errs.WrapMessage(codes.InvalidArgument, "min amount is greater than max amount")
+	}
+	if filter.MinAmount > 0 {
+		db = db.Where("amount>=?", filter.MinAmount)
+	}
+	if filter.MaxAmount > 0 {
+		db = db.Where("amount<=?", filter.MaxAmount)
+	}
+
+	if names := strings.TrimSpace(filter.CustomerNames); names != "" {
+		if terms := fullTextTerms(names); terms != "" {
+			db = db.Where(
+				"(initiator_customer_names LIKE ? OR MATCH(initiator_customer_names) AGAINST(? IN BOOLEAN MODE))",
+				likePrefix(names), terms,
+			)
+		} else {
+			db = db.Where("initiator_customer_names LIKE ?", likePrefix(names))
+		}
+	}
+
+	if terms := fullTextTerms(filter.TransactionDesc); terms != "" {
+		db = db.Where("MATCH(transaction_desc) AGAINST(? IN BOOLEAN MODE)", terms)
+	}
+
+	if query := strings.TrimSpace(filter.Query); query != "" {
+		prefix := likePrefix(query)
+		conds := []string{
+			"mpesa_receipt_id LIKE ?",
+			"phone_number LIKE ?",
+			"account_reference LIKE ?",
+			"initiator_customer_names LIKE ?",
+		}
+		args := []interface{}{prefix, likePrefix(formatutil.FormatPhoneKE(query)), prefix, prefix}
+		if terms := fullTextTerms(query); terms != "" {
+			conds = append(conds, "MATCH(initiator_customer_names) AGAINST(? IN BOOLEAN MODE)")
+			args = append(args, terms)
+		}
+		db = db.Where("("+strings.Join(conds, " OR ")+")", args...)
+	}
+
+	return db, nil
+}
+
+// likePrefix returns a LIKE pattern matching values starting with s
+func likePrefix(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s) + "%"
+}
+
+// minFullTextWord is the default innodb_ft_min_token_size; shorter words are not indexed
+const minFullTextWord = 3
+
+// fullTextTerms returns a boolean mode full-text search expression requiring every word in s as a word prefix.
+// Words too short to be indexed are left out.
+func fullTextTerms(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if utf8.RuneCountInString(word) >= minFullTextWord {
+			terms = append(terms, "+"+word+"*")
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+func (stkAPI *stkAPIServer) WatchStkTransactions(
+	req *stk.WatchStkTransactionsRequest, stream stk.StkPushV1_WatchStkTransactionsServer,
+) error {
+	ctx := stream.Context()
+
+	// Authorization
+	actor, err := stkAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Validation
+	if req == nil {
+		return errs.MissingField("watch request")
+	}
+
+	allowedPhones, err := stkAPI.allowedPhones(ctx, actor.ID)
+	if err != nil {
+		return err
+	}
+
+	events, stop := stkAPI.updates.watch()
+	defer stop()
+
+	// Let client know the stream is established
+	err = stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-events:
+			if !matchesFilter(event.GetStkTransaction(), req.GetFilter(), allowedPhones) {
+				continue
+			}
+			err = stream.Send(event)
+			if err != nil {
+				return err
+			}
+		}
+	}
 }
 
 func getTime(dateStr string) (time.Time, error) {
@@ -635,13 +1181,12 @@ func (stkAPI *stkAPIServer) ProcessStkTransaction(
 	ctx context.Context, req *stk.ProcessStkTransactionRequest,
 ) (*emptypb.Empty, error) {
 	// Authorization
-	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	actor, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
 	if err != nil {
 		return nil, err
 	}
 
 	// Validation
-	var key int
 	switch {
 	case req == nil:
 		return nil, errs.MissingField("process request")
@@ -649,23 +1194,15 @@ func (stkAPI *stkAPIServer) ProcessStkTransaction(
 		return nil, errs.MissingField("transaction/mpesa id")
 	}
 
-	processed := "NO"
-	if req.Processed {
-		processed = "YES"
-	}
-
-	if req.TransactionId != 0 {
-		err = stkAPI.SQLDB.Model(&STKTransaction{}).Unscoped().Where("id=?", key).
-			Update("processed", processed).Error
-	} else {
-		err = stkAPI.SQLDB.Model(&STKTransaction{}).Unscoped().Where("mpesa_receipt_id=?", req.MpesaReceiptId).
-			Update("processed", processed).Error
-	}
-	switch {
-	case err == nil:
-	default:
-		stkAPI.Logger.Errorln(err)
-		return nil, errs.WrapMessage(codes.Internal, "failed to process stk transaction")
+	_, err = stkAPI.processTransaction(ctx, &processParams{
+		TransactionID:  req.TransactionId,
+		MpesaReceiptID: req.MpesaReceiptId,
+		Processed:      req.Processed,
+		Source:         stk.StkEventSource_STK_SOURCE_MANUAL,
+		ActorID:        actor.ID,
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -688,43 +1225,50 @@ func (stkAPI *stkAPIServer) PublishStkTransaction(
 		return nil, errs.MissingField("publish message")
 	}
 
-	pb := req.GetPublishMessage().GetTransactionInfo()
-
-	// Marshal data
-	bs, err := proto.Marshal(req.PublishMessage)
+	err = stkAPI.publish(ctx, req)
 	if err != nil {
-		return nil, errs.FromProtoMarshal(err, "publish message")
+		return nil, err
 	}
 
-	channel := req.GetPublishMessage().GetPublishInfo().GetChannelName()
-	if channel == "" {
-		return &emptypb.Empty{}, nil
-	}
+	return &emptypb.Empty{}, nil
+}
+
+// publish publishes the stk transaction to the channel and webhook in publish message
+func (stkAPI *stkAPIServer) publish(ctx context.Context, req *stk.PublishStkTransactionRequest) error {
+	pb := req.GetPublishMessage().GetTransactionInfo()
 
 	// Publish based on state
 	switch req.ProcessedState {
-	case stk.StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED:
-		err = stkAPI.RedisDB.Publish(ctx, channel, bs).Err()
-		if err != nil {
-			return nil, errs.WrapMessagef(codes.Internal, "publish failed: %v", err)
-		}
 	case stk.StkProcessedState_STK_PROCESSED:
 		// Publish only if the processed state is true
-		if pb.GetProcessed() {
-			err = stkAPI.RedisDB.Publish(ctx, channel, bs).Err()
-			if err != nil {
-				return nil, errs.WrapMessagef(codes.Internal, "publish failed: %v", err)
-			}
+		if !pb.GetProcessed() {
+			return nil
 		}
 	case stk.StkProcessedState_STK_NOT_PROCESSED:
 		// Publish only if the processed state is false
-		if !pb.GetProcessed() {
-			err = stkAPI.RedisDB.Publish(ctx, channel, bs).Err()
-			if err != nil {
-				return nil, errs.WrapMessagef(codes.Internal, "publish failed: %v", err)
-			}
+		if pb.GetProcessed() {
+			return nil
 		}
 	}
 
-	return &emptypb.Empty{}, nil
+	channel := req.GetPublishMessage().GetPublishInfo().GetChannelName()
+	if channel != "" {
+		// Marshal data
+		bs, err := stkAPI.encodeMessage(req.PublishMessage)
+		if err != nil {
+			return err
+		}
+
+		err = stkAPI.Publisher.Publish(ctx, channel, bs)
+		if err != nil {
+			return errs.WrapMessagef(codes.Internal, "publish failed: %v", err)
+		}
+	}
+
+	err := stkAPI.enqueueWebhook(ctx, req.PublishMessage)
+	if err != nil {
+		return errs.WrapMessagef(codes.Internal, "webhook failed: %v", err)
+	}
+
+	return nil
 }
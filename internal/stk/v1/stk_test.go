@@ -0,0 +1,70 @@
+package stk
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+)
+
+func TestTimestampAndPassword(t *testing.T) {
+	stkAPI := &stkAPIServer{Options: &Options{
+		NowFunc: func() time.Time { return time.Date(2016, 2, 16, 13, 56, 27, 0, time.UTC) },
+	}}
+
+	// Daraja timestamps are in East Africa Time
+	timestamp := stkAPI.timestamp()
+	if timestamp != "20160216165627" {
+		t.Fatalf("timestamp() = %s, want 20160216165627", timestamp)
+	}
+
+	// Sandbox credentials from daraja documentation
+	cred := &ShortCodeCredential{
+		ShortCode: "174379",
+		PassKey:   "bfb279f9aa9bdbcf158e97dd71a467cd2e0c893059b10f78e6b72ada1ed2c919",
+	}
+
+	want := "MTc0Mzc5YmZiMjc5ZjlhYTliZGJjZjE1OGU5N2RkNzFhNDY3Y2QyZTBjODkzMDU5YjEwZjc4ZTZiNzJhZGExZWQyYzkxOTIwMTYwMjE2MTY1NjI3"
+	if got := cred.password(timestamp); got != want {
+		t.Errorf("password() = %s, want %s", got, want)
+	}
+}
+
+func TestWaitStkResultRequestFailed(t *testing.T) {
+	stkAPI := &stkAPIServer{Options: &Options{}, updates: newUpdatesHub()}
+
+	var (
+		current = stk.StkStatus_STK_REQUEST_SUBMITED
+		loads   = make(chan struct{}, 10)
+		mu      sync.Mutex
+	)
+	load := func() (*stk.StkTransaction, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		loads <- struct{}{}
+		return &stk.StkTransaction{TransactionId: 1, Status: current}, nil
+	}
+
+	// The request fails after the first read
+	go func() {
+		<-loads
+		mu.Lock()
+		current = stk.StkStatus_STK_REQUEST_FAILED
+		mu.Unlock()
+		stkAPI.updates.notify(&stk.WatchStkTransactionsResponse{StkTransaction: &stk.StkTransaction{TransactionId: 1}})
+	}()
+
+	start := time.Now()
+	pb, err := stkAPI.waitSettled(context.Background(), 1, time.Minute, load)
+	if err != nil {
+		t.Fatalf("waitSettled() error = %v", err)
+	}
+	if pb.Status != stk.StkStatus_STK_REQUEST_FAILED {
+		t.Errorf("waitSettled() status = %s, want %s", pb.Status, stk.StkStatus_STK_REQUEST_FAILED)
+	}
+	if elapsed := time.Since(start); elapsed >= waitPollInterval {
+		t.Errorf("waitSettled() returned after %v, want return on update", elapsed)
+	}
+}
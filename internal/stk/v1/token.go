@@ -0,0 +1,256 @@
+package stk
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesapayments/pkg/utils/httputils"
+	redis "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/grpclog"
+)
+
+// AccessTokenProvider provides daraja access tokens for shortcode credentials
+type AccessTokenProvider interface {
+	// AccessToken returns a valid access token for the credentials
+	AccessToken(ctx context.Context, cred *ShortCodeCredential) (string, error)
+	// RefreshAccessToken replaces the stale access token, e.g. after daraja rejects it with 401
+	RefreshAccessToken(ctx context.Context, cred *ShortCodeCredential, stale string) (string, error)
+}
+
+// RedisTokenProviderOptions contains options for creating a redis backed access token provider
+type RedisTokenProviderOptions struct {
+	RedisDB        *redis.Client
+	HTTPClient     HTTPClient
+	Logger         grpclog.LoggerV2
+	AccessTokenURL string
+	RefreshBefore  time.Duration
+	LockTTL        time.Duration
+}
+
+// ValidateRedisTokenProviderOptions validates options for redis access token provider
+func ValidateRedisTokenProviderOptions(opt *RedisTokenProviderOptions) error {
+	var err error
+	switch {
+	case opt == nil:
+		err = errs.MissingField("token provider options")
+	case opt.RedisDB == nil:
+		err = errs.MissingField("redis db")
+	case opt.HTTPClient == nil:
+		err = errs.MissingField("http client")
+	case opt.Logger == nil:
+		err = errs.MissingField("logger")
+	case opt.AccessTokenURL == "":
+		err = errs.MissingField("access token url")
+	}
+	return err
+}
+
+type redisTokenProvider struct {
+	*RedisTokenProviderOptions
+}
+
+// NewRedisTokenProvider creates an access token provider that shares tokens across replicas through redis.
+//
+// Tokens are cached with the expiry returned by daraja and refreshed by a single replica holding a lock.
+func NewRedisTokenProvider(opt *RedisTokenProviderOptions) (AccessTokenProvider, error) {
+	err := ValidateRedisTokenProviderOptions(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.RefreshBefore <= 0 {
+		opt.RefreshBefore = 5 * time.Minute
+	}
+	if opt.LockTTL <= 0 {
+		opt.LockTTL = 15 * time.Second
+	}
+
+	return &redisTokenProvider{RedisTokenProviderOptions: opt}, nil
+}
+
+// accessTokenKey is key storing access token for consumer key
+func accessTokenKey(consumerKey string) string {
+	sum := sha256.Sum256([]byte(consumerKey))
+	return fmt.Sprintf("stk:accesstoken:%s", hex.EncodeToString(sum[:8]))
+}
+
+func accessTokenLockKey(consumerKey string) string {
+	return accessTokenKey(consumerKey) + ":lock"
+}
+
+// releaseLockScript deletes the lock only if it is still held by the caller
+var releaseLockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+func (p *redisTokenProvider) cachedToken(ctx context.Context, cred *ShortCodeCredential) (string, time.Duration, error) {
+	key := accessTokenKey(cred.ConsumerKey)
+
+	pipe := p.RedisDB.Pipeline()
+	getCmd := pipe.Get(ctx, key)
+	ttlCmd := pipe.PTTL(ctx, key)
+
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return getCmd.Val(), ttlCmd.Val(), nil
+}
+
+func (p *redisTokenProvider) AccessToken(ctx context.Context, cred *ShortCodeCredential) (string, error) {
+	token, ttl, err := p.cachedToken(ctx, cred)
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return p.refresh(ctx, cred, "", true)
+	default:
+		return "", fmt.Errorf("failed to get access token from cache: %v", err)
+	}
+
+	// Refresh proactively before the token expires
+	if ttl >= 0 && ttl < p.RefreshBefore {
+		newToken, err := p.refresh(ctx, cred, token, false)
+		if err != nil {
+			p.Logger.Warningf("failed to refresh access token before expiry: %v", err)
+			return token, nil
+		}
+		return newToken, nil
+	}
+
+	return token, nil
+}
+
+func (p *redisTokenProvider) RefreshAccessToken(ctx context.Context, cred *ShortCodeCredential, stale string) (string, error) {
+	return p.refresh(ctx, cred, stale, true)
+}
+
+// refresh fetches a new token if the cached token is missing or stale. When another replica holds the
+// refresh lock, it waits for the new token if wait is true otherwise it returns the stale token.
+func (p *redisTokenProvider) refresh(ctx context.Context, cred *ShortCodeCredential, stale string, wait bool) (string, error) {
+	var (
+		key     = accessTokenKey(cred.ConsumerKey)
+		lockKey = accessTokenLockKey(cred.ConsumerKey)
+		lockVal = randomHex(16)
+	)
+
+	ok, err := p.RedisDB.SetNX(ctx, lockKey, lockVal, p.LockTTL).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to acquire access token lock: %v", err)
+	}
+
+	if !ok {
+		if !wait {
+			return stale, nil
+		}
+		return p.waitForToken(ctx, key, stale)
+	}
+
+	defer func() {
+		err := releaseLockScript.Run(context.Background(), p.RedisDB, []string{lockKey}, lockVal).Err()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			p.Logger.Errorf("failed to release access token lock: %v", err)
+		}
+	}()
+
+	// Another replica may have refreshed the token before we got the lock
+	token, ttl, err := p.cachedToken(ctx, cred)
+	if err == nil && token != stale && ttl >= p.RefreshBefore {
+		return token, nil
+	}
+
+	token, expiresIn, err := p.fetchAccessToken(ctx, cred)
+	if err != nil {
+		return "", err
+	}
+
+	err = p.RedisDB.Set(ctx, key, token, expiresIn).Err()
+	if err != nil {
+		return "", fmt.Errorf("failed to save access token to cache: %v", err)
+	}
+
+	p.Logger.Infof("access token updated for short code %s", cred.ShortCode)
+
+	return token, nil
+}
+
+func (p *redisTokenProvider) waitForToken(ctx context.Context, key, stale string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.LockTTL)
+	defer cancel()
+
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", errors.New("timed out waiting for access token refresh")
+		case <-ticker.C:
+			token, err := p.RedisDB.Get(ctx, key).Result()
+			if err == nil && token != stale {
+				return token, nil
+			}
+		}
+	}
+}
+
+func (p *redisTokenProvider) fetchAccessToken(ctx context.Context, cred *ShortCodeCredential) (string, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.AccessTokenURL, nil)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", cred.basicToken()))
+
+	httputils.DumpRequest(req, "STK ACCESS TOKEN REQUEST")
+
+	res, err := p.HTTPClient.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	httputils.DumpResponse(res, "STK ACCESS TOKEN RESPONSE")
+
+	if res.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("expected status code OK got: %v", res.Status)
+	}
+
+	resTo := make(map[string]interface{})
+	err = json.NewDecoder(res.Body).Decode(&resTo)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", 0, fmt.Errorf("failed to json decode response: %v", err)
+	}
+
+	token, _ := resTo["access_token"].(string)
+	if token == "" {
+		return "", 0, errors.New("missing access token in response")
+	}
+
+	// Daraja sends expires_in as a string of seconds
+	expiresIn, err := strconv.Atoi(fmt.Sprint(resTo["expires_in"]))
+	if err != nil || expiresIn <= 0 {
+		expiresIn = 3599
+	}
+
+	return token, time.Duration(expiresIn) * time.Second, nil
+}
+
+func randomHex(n int) string {
+	bs := make([]byte, n)
+	_, _ = rand.Read(bs)
+	return hex.EncodeToString(bs)
+}
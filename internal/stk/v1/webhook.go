@@ -0,0 +1,662 @@
+package stk
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// Headers sent with webhook deliveries.
+//
+// The signature is hex encoded HMAC-SHA256 of "<timestamp>.<body>" prefixed with "sha256=".
+const (
+	WebhookSignatureHeader  = "X-Stk-Signature"
+	WebhookTimestampHeader  = "X-Stk-Timestamp"
+	WebhookDeliveryIDHeader = "X-Stk-Delivery-Id"
+)
+
+const (
+	defaultWebhookMaxAttempts = 8
+	webhookBaseBackoff        = 30 * time.Second
+	webhookMaxBackoff         = time.Hour
+	webhookTimeout            = 15 * time.Second
+	webhookLease              = time.Minute
+	webhookWorkerInterval     = 10 * time.Second
+)
+
+// STKInitiatorWebhook is the default webhook of an initiator
+type STKInitiatorWebhook struct {
+	InitiatorID string    `gorm:"primaryKey;type:varchar(50)"`
+	WebhookURL  string    `gorm:"type:varchar(300);not null"`
+	Secret      string    `gorm:"type:varchar(100);not null"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime;type:datetime(6)"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;type:datetime(6);not null"`
+}
+
+// InitiatorWebhooksTable is table for initiators default webhooks
+const InitiatorWebhooksTable = "stk_initiator_webhooks"
+
+// TableName returns the name of the table
+func (*STKInitiatorWebhook) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), InitiatorWebhooksTable)
+	}
+	return InitiatorWebhooksTable
+}
+
+// STKWebhookDelivery is delivery of publish message to a webhook
+type STKWebhookDelivery struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement"`
+	TransactionID  uint           `gorm:"index"`
+	InitiatorID    string         `gorm:"index;type:varchar(50)"`
+	WebhookURL     string         `gorm:"type:varchar(300);not null"`
+	Payload        string         `gorm:"type:text;not null"`
+	State          string         `gorm:"index:idx_state_next_attempt;type:varchar(30)"`
+	Attempts       int32          `gorm:"type:int;not null;default:0"`
+	NextAttemptAt  sql.NullTime   `gorm:"index:idx_state_next_attempt;type:datetime(6)"`
+	LockedUntil    sql.NullTime   `gorm:"type:datetime(6)"`
+	LastStatusCode int32          `gorm:"type:int"`
+	LastError      sql.NullString `gorm:"type:varchar(300)"`
+	UpdatedAt      time.Time      `gorm:"autoUpdateTime;type:datetime(6)"`
+	CreatedAt      time.Time      `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+}
+
+// WebhookDeliveriesTable is table for webhook deliveries
+const WebhookDeliveriesTable = "stk_webhook_deliveries"
+
+// TableName returns the name of the table
+func (*STKWebhookDelivery) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), WebhookDeliveriesTable)
+	}
+	return WebhookDeliveriesTable
+}
+
+// STKWebhookAttempt is a single attempt of webhook delivery
+type STKWebhookAttempt struct {
+	ID         uint           `gorm:"primaryKey;autoIncrement"`
+	DeliveryID uint           `gorm:"index;not null"`
+	StatusCode int32          `gorm:"type:int"`
+	Error      sql.NullString `gorm:"type:varchar(300)"`
+	DurationMs int64          `gorm:"type:int"`
+	CreatedAt  time.Time      `gorm:"autoCreateTime;type:datetime(6);not null"`
+}
+
+// WebhookAttemptsTable is table for webhook delivery attempts
+const WebhookAttemptsTable = "stk_webhook_attempts"
+
+// TableName returns the name of the table
+func (*STKWebhookAttempt) TableName() string {
+	// Get table prefix
+	if viper.GetString("STK_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("STK_TABLE_PREFIX"), WebhookAttemptsTable)
+	}
+	return WebhookAttemptsTable
+}
+
+// DeliveryToProto returns the protobuf message of webhook delivery
+func DeliveryToProto(db *STKWebhookDelivery, attempts []*STKWebhookAttempt) (*stk.WebhookDelivery, error) {
+	if db == nil {
+		return nil, errs.MissingField("webhook delivery")
+	}
+
+	pb := &stk.WebhookDelivery{
+		DeliveryId:      uint64(db.ID),
+		TransactionId:   uint64(db.TransactionID),
+		InitiatorId:     db.InitiatorID,
+		WebhookUrl:      db.WebhookURL,
+		Payload:         db.Payload,
+		State:           stk.WebhookDeliveryState(stk.WebhookDeliveryState_value[db.State]),
+		Attempts:        db.Attempts,
+		LastStatusCode:  db.LastStatusCode,
+		LastError:       db.LastError.String,
+		AttemptHistory:  make([]*stk.WebhookAttempt, 0, len(attempts)),
+		CreateTimestamp: db.CreatedAt.UTC().Unix(),
+		UpdateTimestamp: db.UpdatedAt.UTC().Unix(),
+	}
+
+	if db.NextAttemptAt.Valid {
+		pb.NextAttemptTimestamp = db.NextAttemptAt.Time.UTC().Unix()
+	}
+
+	for _, attempt := range attempts {
+		pb.AttemptHistory = append(pb.AttemptHistory, &stk.WebhookAttempt{
+			AttemptId:       uint64(attempt.ID),
+			StatusCode:      attempt.StatusCode,
+			Error:           attempt.Error.String,
+			DurationMs:      attempt.DurationMs,
+			CreateTimestamp: attempt.CreatedAt.UTC().Unix(),
+		})
+	}
+
+	return pb, nil
+}
+
+// SignWebhook returns the signature of webhook body sent at timestamp
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff returns how long to wait before the next attempt after the given number of attempts
+func webhookBackoff(attempts int32) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// initiatorWebhook returns the default webhook of initiator or nil if there is none
+func (stkAPI *stkAPIServer) initiatorWebhook(initiatorID string) (*STKInitiatorWebhook, error) {
+	if initiatorID == "" {
+		return nil, nil
+	}
+
+	db := &STKInitiatorWebhook{}
+	err := stkAPI.SQLDB.First(db, "initiator_id = ?", initiatorID).Error
+	switch {
+	case err == nil:
+		return db, nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// webhookSecret returns the secret for signing deliveries of the initiator
+func (stkAPI *stkAPIServer) webhookSecret(initiatorID string) (string, error) {
+	hook, err := stkAPI.initiatorWebhook(initiatorID)
+	if err != nil {
+		return "", err
+	}
+	if hook != nil && hook.Secret != "" {
+		return hook.Secret, nil
+	}
+	return stkAPI.WebhookSecret, nil
+}
+
+// validateWebhookURL checks that the webhook url is an absolute http(s) url whose host is allowed
+func (stkAPI *stkAPIServer) validateWebhookURL(webhookURL string) error {
+	u, err := url.ParseRequestURI(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errs.IncorrectVal("webhook url")
+	}
+
+	if len(stkAPI.WebhookAllowedHosts) == 0 {
+		return nil
+	}
+	for _, host := range stkAPI.WebhookAllowedHosts {
+		if strings.EqualFold(u.Hostname(), host) {
+			return nil
+		}
+	}
+
+	return errs.WrapMessagef(codes.InvalidArgument, "webhook host %s is not allowed", u.Hostname())
+}
+
+// webhookDelivery returns delivery of the publish message to the webhook in publish info or the
+// initiator default webhook. It returns nil when there is no webhook.
+func (stkAPI *stkAPIServer) webhookDelivery(msg *stk.PublishMessage) (*STKWebhookDelivery, error) {
+	webhookURL := msg.GetPublishInfo().GetWebhookUrl()
+	if webhookURL == "" {
+		hook, err := stkAPI.initiatorWebhook(msg.GetInitiatorId())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get initiator webhook: %v", err)
+		}
+		if hook == nil {
+			return nil, nil
+		}
+		webhookURL = hook.WebhookURL
+	}
+
+	bs, err := stkAPI.encodeWebhookMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &STKWebhookDelivery{
+		TransactionID: uint(msg.GetTransactionId()),
+		InitiatorID:   msg.GetInitiatorId(),
+		WebhookURL:    webhookURL,
+		Payload:       string(bs),
+		State:         stk.WebhookDeliveryState_WEBHOOK_DELIVERY_PENDING.String(),
+		NextAttemptAt: sql.NullTime{Time: stkAPI.NowFunc().UTC(), Valid: true},
+	}, nil
+}
+
+// enqueueWebhook saves delivery of the publish message and makes the first attempt
+func (stkAPI *stkAPIServer) enqueueWebhook(ctx context.Context, msg *stk.PublishMessage) error {
+	db, err := stkAPI.webhookDelivery(msg)
+	if err != nil || db == nil {
+		return err
+	}
+
+	err = stkAPI.SQLDB.Create(db).Error
+	if err != nil {
+		return fmt.Errorf("failed to save webhook delivery: %v", err)
+	}
+
+	// First attempt is immediate; retries are picked by the worker
+	go stkAPI.deliverWebhook(context.Background(), db.ID)
+
+	return nil
+}
+
+func (stkAPI *stkAPIServer) webhookWorker(ctx context.Context) {
+	ticker := time.NewTicker(webhookWorkerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		ids := make([]uint, 0)
+
+		err := stkAPI.SQLDB.Model(&STKWebhookDelivery{}).Limit(100).Order("next_attempt_at ASC").
+			Where("state = ? AND next_attempt_at <= ?", stk.WebhookDeliveryState_WEBHOOK_DELIVERY_PENDING.String(), stkAPI.NowFunc().UTC()).
+			Where("locked_until IS NULL OR locked_until < ?", stkAPI.NowFunc().UTC()).
+			Pluck("id", &ids).Error
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to get due webhook deliveries: %v", err)
+			continue
+		}
+
+		var (
+			wg  = &sync.WaitGroup{}
+			sem = make(chan struct{}, 5)
+		)
+
+		for _, id := range ids {
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func(id uint) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				stkAPI.deliverWebhook(ctx, id)
+			}(id)
+		}
+
+		wg.Wait()
+	}
+}
+
+// deliverWebhook makes a delivery attempt if no other replica is attempting it
+func (stkAPI *stkAPIServer) deliverWebhook(ctx context.Context, id uint) {
+	now := stkAPI.NowFunc().UTC()
+
+	// Claim the delivery
+	res := stkAPI.SQLDB.Model(&STKWebhookDelivery{}).
+		Where("id = ? AND state = ?", id, stk.WebhookDeliveryState_WEBHOOK_DELIVERY_PENDING.String()).
+		Where("locked_until IS NULL OR locked_until < ?", now).
+		Update("locked_until", now.Add(webhookLease))
+	if res.Error != nil {
+		stkAPI.Logger.Errorf("failed to claim webhook delivery %d: %v", id, res.Error)
+		return
+	}
+	if res.RowsAffected == 0 {
+		return
+	}
+
+	db := &STKWebhookDelivery{}
+	err := stkAPI.SQLDB.First(db, "id = ?", id).Error
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to get webhook delivery %d: %v", id, err)
+		return
+	}
+
+	start := time.Now()
+	statusCode, errPost := stkAPI.postWebhook(ctx, db)
+
+	attempt := &STKWebhookAttempt{
+		DeliveryID: db.ID,
+		StatusCode: int32(statusCode),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if errPost != nil {
+		attempt.Error = sql.NullString{String: truncate(errPost.Error(), 300), Valid: true}
+	}
+
+	attempts := db.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":         attempts,
+		"last_status_code": int32(statusCode),
+		"last_error":       attempt.Error,
+		"locked_until":     sql.NullTime{},
+	}
+
+	maxAttempts := stkAPI.WebhookMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultWebhookMaxAttempts
+	}
+
+	switch {
+	case errPost == nil:
+		updates["state"] = stk.WebhookDeliveryState_WEBHOOK_DELIVERY_SUCCEEDED.String()
+		updates["next_attempt_at"] = sql.NullTime{}
+	case attempts >= int32(maxAttempts):
+		updates["state"] = stk.WebhookDeliveryState_WEBHOOK_DELIVERY_FAILED.String()
+		updates["next_attempt_at"] = sql.NullTime{}
+		stkAPI.Logger.Warningf("webhook delivery %d failed after %d attempts: %v", db.ID, attempts, errPost)
+	default:
+		updates["next_attempt_at"] = sql.NullTime{Time: stkAPI.NowFunc().UTC().Add(webhookBackoff(attempts)), Valid: true}
+	}
+
+	err = stkAPI.SQLDB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(attempt).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(db).Updates(updates).Error
+	})
+	if err != nil {
+		stkAPI.Logger.Errorf("failed to save webhook delivery %d attempt: %v", db.ID, err)
+	}
+}
+
+// postWebhook sends the delivery payload returning the response status code
+func (stkAPI *stkAPIServer) postWebhook(ctx context.Context, db *STKWebhookDelivery) (int, error) {
+	secret, err := stkAPI.webhookSecret(db.InitiatorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get webhook secret: %v", err)
+	}
+	if secret == "" {
+		return 0, errors.New("missing webhook secret")
+	}
+
+	_, err = url.ParseRequestURI(db.WebhookURL)
+	if err != nil {
+		return 0, fmt.Errorf("incorrect webhook url: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	var (
+		body      = []byte(db.Payload)
+		timestamp = strconv.FormatInt(stkAPI.NowFunc().Unix(), 10)
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, db.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", stkAPI.webhookContentType())
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookDeliveryIDHeader, fmt.Sprint(db.ID))
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(secret, timestamp, body))
+
+	res, err := stkAPI.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %s", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
+
+func (stkAPI *stkAPIServer) SetInitiatorWebhook(
+	ctx context.Context, req *stk.SetInitiatorWebhookRequest,
+) (*stk.InitiatorWebhook, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request")
+	case req.InitiatorId == "":
+		return nil, errs.MissingField("initiator id")
+	case req.WebhookUrl == "":
+		return nil, errs.MissingField("webhook url")
+	case len(req.Secret) > 100:
+		return nil, errs.IncorrectVal("secret")
+	}
+
+	err = stkAPI.validateWebhookURL(req.WebhookUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	db := &STKInitiatorWebhook{
+		InitiatorID: req.InitiatorId,
+		WebhookURL:  req.WebhookUrl,
+		Secret:      firstVal(req.Secret, randomHex(32)),
+	}
+
+	err = stkAPI.SQLDB.Save(db).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to save initiator webhook")
+	}
+
+	return &stk.InitiatorWebhook{
+		InitiatorId:     db.InitiatorID,
+		WebhookUrl:      db.WebhookURL,
+		Secret:          db.Secret,
+		UpdateTimestamp: db.UpdatedAt.UTC().Unix(),
+	}, nil
+}
+
+func (stkAPI *stkAPIServer) ListWebhookDeliveries(
+	ctx context.Context, req *stk.ListWebhookDeliveriesRequest,
+) (*stk.ListWebhookDeliveriesResponse, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	case req.PageSize < 0:
+		return nil, errs.IncorrectVal("page size")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	var id uint64
+
+	if req.GetPageToken() != "" {
+		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+		}
+		id, err = strconv.ParseUint(string(bs), 10, 64)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+		}
+	}
+
+	db := stkAPI.SQLDB.Model(&STKWebhookDelivery{}).Order("id DESC").Limit(int(pageSize) + 1)
+	if id != 0 {
+		db = db.Where("id < ?", id)
+	}
+
+	if filter := req.GetFilter(); filter != nil {
+		if len(filter.InitiatorIds) > 0 {
+			db = db.Where("initiator_id IN(?)", filter.InitiatorIds)
+		}
+		if len(filter.TransactionIds) > 0 {
+			db = db.Where("transaction_id IN(?)", filter.TransactionIds)
+		}
+		if len(filter.States) > 0 {
+			ss := make([]string, 0, len(filter.States))
+			for _, s := range filter.States {
+				ss = append(ss, s.String())
+			}
+			db = db.Where("state IN(?)", ss)
+		}
+	}
+
+	dbs := make([]*STKWebhookDelivery, 0, pageSize+1)
+
+	err = db.Find(&dbs).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list webhook deliveries")
+	}
+
+	var token string
+	if len(dbs) > int(pageSize) {
+		dbs = dbs[:pageSize]
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(dbs[len(dbs)-1].ID)))
+	}
+
+	attempts, err := stkAPI.deliveryAttempts(dbs...)
+	if err != nil {
+		return nil, err
+	}
+
+	pbs := make([]*stk.WebhookDelivery, 0, len(dbs))
+
+	for _, db := range dbs {
+		pb, err := DeliveryToProto(db, attempts[db.ID])
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+
+	return &stk.ListWebhookDeliveriesResponse{
+		NextPageToken: token,
+		Deliveries:    pbs,
+	}, nil
+}
+
+// deliveryAttempts returns attempts of the deliveries keyed by delivery id
+func (stkAPI *stkAPIServer) deliveryAttempts(dbs ...*STKWebhookDelivery) (map[uint][]*STKWebhookAttempt, error) {
+	res := make(map[uint][]*STKWebhookAttempt, len(dbs))
+	if len(dbs) == 0 {
+		return res, nil
+	}
+
+	ids := make([]uint, 0, len(dbs))
+	for _, db := range dbs {
+		ids = append(ids, db.ID)
+	}
+
+	attempts := make([]*STKWebhookAttempt, 0)
+
+	err := stkAPI.SQLDB.Order("id ASC").Find(&attempts, "delivery_id IN(?)", ids).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get webhook attempts")
+	}
+
+	for _, attempt := range attempts {
+		res[attempt.DeliveryID] = append(res[attempt.DeliveryID], attempt)
+	}
+
+	return res, nil
+}
+
+func (stkAPI *stkAPIServer) RetryWebhookDelivery(
+	ctx context.Context, req *stk.RetryWebhookDeliveryRequest,
+) (*stk.WebhookDelivery, error) {
+	// Authorization
+	_, err := stkAPI.AuthAPI.AuthorizeGroups(ctx, stkAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("request")
+	case req.DeliveryId == 0:
+		return nil, errs.MissingField("delivery id")
+	}
+
+	db := &STKWebhookDelivery{}
+	err = stkAPI.SQLDB.First(db, "id = ?", req.DeliveryId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("webhook delivery", fmt.Sprint(req.DeliveryId))
+	default:
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get webhook delivery")
+	}
+
+	if db.State == stk.WebhookDeliveryState_WEBHOOK_DELIVERY_SUCCEEDED.String() {
+		return nil, errs.WrapMessage(codes.FailedPrecondition, "webhook delivery already succeeded")
+	}
+
+	// Attempts start afresh so that the delivery gets the full retry schedule
+	err = stkAPI.SQLDB.Model(db).Updates(map[string]interface{}{
+		"state":           stk.WebhookDeliveryState_WEBHOOK_DELIVERY_PENDING.String(),
+		"attempts":        0,
+		"next_attempt_at": sql.NullTime{Time: stkAPI.NowFunc().UTC(), Valid: true},
+		"locked_until":    sql.NullTime{},
+	}).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to retry webhook delivery")
+	}
+
+	// Attempt now and return the outcome
+	stkAPI.deliverWebhook(ctx, db.ID)
+
+	err = stkAPI.SQLDB.First(db, "id = ?", db.ID).Error
+	if err != nil {
+		stkAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get webhook delivery")
+	}
+
+	attempts, err := stkAPI.deliveryAttempts(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeliveryToProto(db, attempts[db.ID])
+}
@@ -0,0 +1,32 @@
+package stk
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url          string
+		allowedHosts []string
+		code         codes.Code
+	}{
+		{url: "https://merchant.example.com/stk"},
+		{url: "http://10.0.0.5:8080/hooks/stk"},
+		{url: "ftp://merchant.example.com/stk", code: codes.InvalidArgument},
+		{url: "https:///stk", code: codes.InvalidArgument},
+		{url: "merchant.example.com/stk", code: codes.InvalidArgument},
+		{url: "https://MERCHANT.example.com/stk", allowedHosts: []string{"merchant.example.com"}},
+		{url: "https://169.254.169.254/latest", allowedHosts: []string{"merchant.example.com"}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		stkAPI := &stkAPIServer{Options: &Options{WebhookAllowedHosts: tt.allowedHosts}}
+		err := stkAPI.validateWebhookURL(tt.url)
+		if code := status.Code(err); code != tt.code {
+			t.Errorf("validateWebhookURL(%q) code = %v, want %v (%v)", tt.url, code, tt.code, err)
+		}
+	}
+}
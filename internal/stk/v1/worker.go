@@ -8,16 +8,18 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"time"
 
-	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
-	"github.com/gidyon/mpesapayments/pkg/payload"
 	"github.com/gidyon/mpesapayments/pkg/utils/httputils"
 	stk "github.com/gidyon/mpesastk/pkg/api/stk/v1"
+	"github.com/gidyon/mpesastk/pkg/payload"
+	"github.com/gidyon/mpesastk/pkg/streams"
 	"github.com/go-redis/redis/v8"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (stkAPI *stkAPIServer) updateAccessTokenWorker(ctx context.Context, dur time.Duration) {
@@ -27,7 +29,7 @@ func (stkAPI *stkAPIServer) updateAccessTokenWorker(ctx context.Context, dur tim
 		expo     = time.Second * 10
 		ticker   = time.NewTicker(xdur)
 		callback = func() {
-			err = stkAPI.updateAccessToken()
+			err = stkAPI.updateAccessTokens(ctx)
 			if err != nil {
 				stkAPI.Logger.Errorf("failed to update access token: %v", err)
 				ticker.Reset(expo + xdur)
@@ -51,36 +53,73 @@ func (stkAPI *stkAPIServer) updateAccessTokenWorker(ctx context.Context, dur tim
 	}
 }
 
-func (stkAPI *stkAPIServer) updateAccessToken() error {
-	req, err := http.NewRequest(http.MethodGet, stkAPI.OptionSTK.AccessTokenURL, nil)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %v", err)
+func (stkAPI *stkAPIServer) updateAccessTokens(ctx context.Context) error {
+	// Pick credentials added to database
+	if stkAPI.LoadCredentialsFromDB {
+		_, err := stkAPI.credentials.LoadFromDB(stkAPI.SQLDB)
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to reload shortcode credentials: %v", err)
+		}
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", stkAPI.OptionSTK.basicToken))
+	failed := make([]string, 0)
 
-	httputils.DumpRequest(req, "STK ACCESS TOKEN REQUEST")
+	// Provider refreshes tokens that are about to expire
+	for _, cred := range stkAPI.credentials.List() {
+		_, err := stkAPI.AccessTokenProvider.AccessToken(ctx, cred)
+		if err != nil {
+			stkAPI.Logger.Errorf("failed to update access token for short code %s: %v", cred.ShortCode, err)
+			failed = append(failed, cred.ShortCode)
+		}
+	}
 
-	res, err := stkAPI.HTTPClient.Do(req)
-	if err != nil && !errors.Is(err, io.EOF) {
-		return fmt.Errorf("request failed: %v", err)
+	if len(failed) > 0 {
+		return fmt.Errorf("access token not updated for short codes: %s", strings.Join(failed, ", "))
 	}
 
-	httputils.DumpResponse(res, "STK ACCESS TOKEN RESPONSE")
+	return nil
+}
 
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("expected status code OK got: %v", res.Status)
+// postDaraja posts the body to daraja API. It retries once with a fresh access token when daraja rejects the token.
+func (stkAPI *stkAPIServer) postDaraja(
+	ctx context.Context, cred *ShortCodeCredential, url string, body []byte, dumpHeader string,
+) (*http.Response, error) {
+	accessToken, err := stkAPI.AccessTokenProvider.AccessToken(ctx, cred)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get access token: %v", err)
 	}
 
-	resTo := make(map[string]interface{})
-	err = json.NewDecoder(res.Body).Decode(&resTo)
-	if err != nil && !errors.Is(err, io.EOF) {
-		return fmt.Errorf("failed to json decode response: %v", err)
-	}
+	for retried := false; ; retried = true {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %v", err)
+		}
 
-	stkAPI.OptionSTK.accessToken = fmt.Sprint(resTo["access_token"])
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+		req.Header.Set("Content-Type", "application/json")
 
-	return nil
+		httputils.DumpRequest(req, dumpHeader+" REQUEST")
+
+		res, err := stkAPI.HTTPClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		httputils.DumpResponse(res, dumpHeader+" RESPONSE")
+
+		if res.StatusCode != http.StatusUnauthorized || retried {
+			return res, nil
+		}
+
+		res.Body.Close()
+
+		stkAPI.Logger.Warningf("access token for short code %s rejected, refreshing", cred.ShortCode)
+
+		accessToken, err = stkAPI.AccessTokenProvider.RefreshAccessToken(ctx, cred, accessToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refresh access token: %v", err)
+		}
+	}
 }
 
 func (stkAPI *stkAPIServer) updateSTKResultsWorker(ctx context.Context, dur time.Duration) {
@@ -101,9 +140,6 @@ func (stkAPI *stkAPIServer) updateSTKResultsWorker(ctx context.Context, dur time
 }
 
 func (stkAPI *stkAPIServer) updateSTKResults(ctx context.Context) (int, error) {
-	if stkAPI.OptionSTK.accessToken == "" {
-		return 0, errors.New("missing access token")
-	}
 	var (
 		sem   = make(chan struct{}, 5)
 		dbs   = make([]*STKTransaction, 0)
@@ -117,7 +153,7 @@ func (stkAPI *stkAPIServer) updateSTKResults(ctx context.Context) (int, error) {
 
 	for next {
 		err = stkAPI.SQLDB.Order("id desc").Limit(limit+1).Model(&STKTransaction{}).
-			Find(&dbs, "stk_status = ? AND id > ? AND created_at < ?", stk.StkStatus_STK_REQUEST_SUBMITED.String(), ID, time.Now().Add(-time.Minute*10)).Error
+			Find(&dbs, "stk_status = ? AND id > ? AND created_at < ?", stk.StkStatus_STK_REQUEST_SUBMITED.String(), ID, stkAPI.NowFunc().Add(-time.Minute*10)).Error
 		if err != nil {
 			return 0, err
 		}
@@ -157,69 +193,86 @@ func (stkAPI *stkAPIServer) updateSTKResults(ctx context.Context) (int, error) {
 	return res, nil
 }
 
-func (stkAPI *stkAPIServer) updateSTKResult(_ context.Context, db *STKTransaction) error {
+// queryStk queries daraja for the result of stk push returning the response and its content type
+func (stkAPI *stkAPIServer) queryStk(
+	ctx context.Context, cred *ShortCodeCredential, checkoutRequestID string,
+) (_ *payload.QueryStkResponse, contentType string, _ error) {
+	timestamp := stkAPI.timestamp()
+
 	req := payload.QueryStkRequest{
-		BusinessShortCode: db.ShortCode,
-		Password:          stkAPI.OptionSTK.password,
-		Timestamp:         stkAPI.OptionSTK.Timestamp,
-		CheckoutRequestID: db.CheckoutRequestID.String,
+		BusinessShortCode: cred.ShortCode,
+		Password:          cred.password(timestamp),
+		Timestamp:         timestamp,
+		CheckoutRequestID: checkoutRequestID,
 	}
 
 	bs, err := json.Marshal(req)
 	if err != nil {
-		return err
-	}
-
-	reqHtpp, err := http.NewRequest(http.MethodPost, stkAPI.OptionSTK.QueryURL, bytes.NewReader(bs))
-	if err != nil {
-		return err
+		return nil, "", err
 	}
 
-	reqHtpp.Header.Set("Authorization", fmt.Sprintf("Bearer %s", stkAPI.OptionSTK.accessToken))
-	reqHtpp.Header.Set("Content-Type", "application/json")
-
-	httputils.DumpRequest(reqHtpp, "QUERY STK STATUS REQUEST")
-
-	res, err := stkAPI.HTTPClient.Do(reqHtpp)
+	res, err := stkAPI.postDaraja(ctx, cred, stkAPI.OptionSTK.QueryURL, bs, "QUERY STK STATUS")
 	if err != nil {
-		return fmt.Errorf("failed to post stk query API: %v", err)
+		return nil, "", fmt.Errorf("failed to post stk query API: %v", err)
 	}
-
-	httputils.DumpResponse(res, "QUERY STK RESULT RESPONSE")
+	defer res.Body.Close()
 
 	resData := &payload.QueryStkResponse{}
 
 	err = json.NewDecoder(res.Body).Decode(&resData)
 	if err != nil && err != io.EOF {
-		return fmt.Errorf("failed to decode mpesa response: %v", err)
+		return nil, "", fmt.Errorf("failed to decode mpesa response: %v", err)
 	}
 
 	if resData.MerchantRequestID == "" || resData.CheckoutRequestID == "" || resData.ResultCode == "" {
-		return errors.New("gotten error while posting to query stk API")
+		return nil, "", errors.New("gotten error while posting to query stk API")
 	}
 
-	succeeded := resData.ResultCode == "0" && strings.Contains(strings.ToLower(resData.ResultDesc), "successfully")
-	status := stk.StkStatus_STK_RESULT_SUCCESS.String()
-	if !succeeded {
-		succeeded = false
-		status = stk.StkStatus_STK_RESULT_FAILED.String()
+	return resData, res.Header.Get("content-type"), nil
+}
+
+func (stkAPI *stkAPIServer) updateSTKResult(ctx context.Context, db *STKTransaction) error {
+	cred, err := stkAPI.credentials.Get(db.ShortCode)
+	if err != nil {
+		return err
+	}
+
+	resData, contentType, err := stkAPI.queryStk(ctx, cred, db.CheckoutRequestID.String)
+	if err != nil {
+		return err
+	}
+
+	succeeded := "YES"
+	status := stk.StkStatus_STK_RESULT_SUCCESS
+	if resData.ResultCode != "0" || !strings.Contains(strings.ToLower(resData.ResultDesc), "successfully") {
+		succeeded = "NO"
+		status = stk.StkStatus_STK_RESULT_FAILED
 	}
 
-	systemId := fmt.Sprintf("%s_%d_%s", firstVal(stkAPI.SystemIdPrefix, "ONFON"), time.Now().UnixNano(), db.MerchantRequestID.String)
+	systemId := fmt.Sprintf("%s_%d_%s", firstVal(stkAPI.SystemIdPrefix, "ONFON"), stkAPI.NowFunc().UnixNano(), db.MerchantRequestID.String)
 
-	switch strings.ToLower(res.Header.Get("content-type")) {
+	switch strings.ToLower(contentType) {
 	case "application/json", "application/json;charset=utf-8":
 		// Update the STK results
-		err = stkAPI.SQLDB.Model(db).Updates(map[string]interface{}{
-			"stk_response_description": resData.ResponseDescription,
-			"stk_response_code":        resData.ResponseCode,
-			"result_description":       resData.ResultDesc,
-			"result_code":              resData.ResultCode,
-			"mpesa_receipt_id":         systemId,
-			"stk_status":               status,
-			"succeeded":                succeeded,
-		}).Error
-		if err != nil {
+		err = TransitionStatus(stkAPI.SQLDB, db, &StatusTransition{
+			To:          status,
+			Source:      stk.StkEventSource_STK_SOURCE_QUERY,
+			Description: resData.ResultDesc,
+			Updates: map[string]interface{}{
+				"stk_response_description": resData.ResponseDescription,
+				"stk_response_code":        resData.ResponseCode,
+				"result_description":       resData.ResultDesc,
+				"result_code":              resData.ResultCode,
+				"mpesa_receipt_id":         systemId,
+				"succeeded":                succeeded,
+			},
+		})
+		switch {
+		case err == nil:
+			stkAPI.notifyUpdate(ctx, stk.StkTransactionEventType_STK_TRANSACTION_UPDATED, db.ID)
+		case errors.Is(err, ErrIllegalTransition):
+			stkAPI.Logger.Infof("stk query result for transaction %d ignored: %v", db.ID, err)
+		default:
 			stkAPI.Logger.Errorln("failed to updated stk transaction: ", err)
 		}
 	default:
@@ -233,6 +286,11 @@ func (stkAPI *stkAPIServer) processWorker(ctx context.Context) {
 
 	stkAPI.Logger.Infof("Listening for process requests on channel: %v", stkAPI.PublishProcessChannel)
 
+	if stkAPI.PublishMode == PublishModeStreams {
+		stkAPI.processStreamWorker(ctx)
+		return
+	}
+
 	ch := stkAPI.RedisDB.Subscribe(ctx, stkAPI.PublishProcessChannel).Channel()
 
 	for {
@@ -243,61 +301,84 @@ func (stkAPI *stkAPIServer) processWorker(ctx context.Context) {
 			go func(msg *redis.Message) {
 				stkAPI.Logger.Infoln("Received process request")
 
-				datas := msg.PayloadSlice
-
-				if len(datas) != 2 {
-					datas = strings.Split(msg.Payload, "/")
-				}
-				if len(datas) != 2 {
-					stkAPI.Logger.Infoln("Incorrect payload")
-					return
+				payload := msg.Payload
+				if len(msg.PayloadSlice) == 2 {
+					payload = strings.Join(msg.PayloadSlice, "/")
 				}
 
-				token, err := stkAPI.AuthAPI.GenToken(
-					ctx,
-					&auth.Payload{
-						ID:           "1",
-						ProjectID:    "-",
-						Names:        "process_stk_worker",
-						PhoneNumber:  "",
-						EmailAddress: "",
-						Group:        auth.DefaultAdminGroup(),
-						Roles:        []string{},
-					},
-					time.Now().Add(time.Minute),
-				)
+				// Pub/sub messages cannot be delivered again
+				err := stkAPI.handleProcessPayload(ctx, []byte(payload), func(error) bool { return true })
 				if err != nil {
-					stkAPI.Logger.Errorf("Failed to generate context: %v", err)
-					return
+					stkAPI.Logger.Errorf("Failed to process transaction: %v", err)
+					_ = stkAPI.deadLetterProcessRequest([]byte(payload), "", 1, err)
 				}
+			}(msg)
+		}
+	}
+}
 
-				md := metadata.Pairs(auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), token))
+// processStreamWorker consumes process requests from the stream so that requests sent while the service
+// is down are not lost. Requests that fail are retried until they succeed, turn out to be incorrect or
+// run out of deliveries, after which they are dead lettered.
+func (stkAPI *stkAPIServer) processStreamWorker(ctx context.Context) {
+	hostname, _ := os.Hostname()
+
+	consumer, err := streams.NewConsumer(&streams.ConsumerOptions{
+		Client:   stkAPI.RedisDB,
+		Stream:   stkAPI.PublishProcessChannel,
+		Group:    processConsumerGroup,
+		Consumer: firstVal(hostname, "stk"),
+		ErrorHandler: func(err error) {
+			stkAPI.Logger.Errorln(err)
+		},
+	})
+	if err != nil {
+		stkAPI.Logger.Errorf("Failed to create process requests consumer: %v", err)
+		return
+	}
 
-				// Communication context
-				ctx := metadata.NewIncomingContext(context.Background(), md)
+	err = consumer.Run(ctx, func(ctx context.Context, msg *streams.Message) error {
+		stkAPI.Logger.Infoln("Received process request")
 
-				// Authorize the context
-				ctx, err = stkAPI.AuthAPI.Authenticator(ctx)
-				if err != nil {
-					stkAPI.Logger.Errorf("Failed to authorize context: %v", err)
-					return
-				}
+		final := func(err error) bool {
+			return permanentProcessError(err) || msg.Deliveries >= stkAPI.processMaxDeliveries()
+		}
 
-				// Lower the processed state
-				datas[1] = strings.ToLower(datas[1])
+		err := stkAPI.handleProcessPayload(ctx, msg.Payload, final)
+		switch {
+		case err == nil:
+			return nil
+		case final(err):
+			stkAPI.Logger.Errorf("Failed to process transaction: %v", err)
+			return stkAPI.deadLetterProcessRequest(msg.Payload, msg.ID, msg.Deliveries, err)
+		default:
+			return err
+		}
+	})
+	if err != nil && ctx.Err() == nil {
+		stkAPI.Logger.Errorf("Process requests consumer stopped: %v", err)
+	}
+}
 
-				// Process transaction
-				_, err = stkAPI.ProcessStkTransaction(ctx, &stk.ProcessStkTransactionRequest{
-					MpesaReceiptId: datas[0],
-					Processed:      datas[1] == "true" || datas[1] == "yes",
-				})
-				if err != nil {
-					stkAPI.Logger.Errorf("Failed to process transaction: %v", err)
-					return
-				}
+// processConsumerGroup is the consumer group for process requests stream
+const processConsumerGroup = "stk-process"
 
-				stkAPI.Logger.Infof("Successfully processed transaction: %v", datas[0])
-			}(msg)
-		}
+// permanentProcessError reports whether retrying the process request cannot help
+func permanentProcessError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound:
+		return true
 	}
+	return false
+}
+
+// deadLetterProcessRequest records the process request that failed
+func (stkAPI *stkAPIServer) deadLetterProcessRequest(payload []byte, sourceID string, attempts int64, cause error) error {
+	return stkAPI.saveDeadLetter(stkAPI.SQLDB, &STKDeadLetter{
+		Kind:     stk.DeadLetterKind_DEAD_LETTER_PROCESS_REQUEST.String(),
+		Channel:  stkAPI.PublishProcessChannel,
+		Payload:  payload,
+		Attempts: int32(attempts),
+		SourceID: sourceID,
+	}, cause)
 }
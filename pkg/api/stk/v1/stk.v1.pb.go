@@ -1,12 +1,14 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
-// 	protoc        v3.14.0
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.5.1-go
 // source: stk.v1.proto
 
 package stk_v1
 
 import (
+	any1 "github.com/golang/protobuf/ptypes/any"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -87,6 +89,116 @@ func (StkStatus) EnumDescriptor() ([]byte, []int) {
 	return file_stk_v1_proto_rawDescGZIP(), []int{0}
 }
 
+type StkTransactionType int32
+
+const (
+	StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED StkTransactionType = 0
+	StkTransactionType_CUSTOMER_PAYBILL_ONLINE          StkTransactionType = 1
+	StkTransactionType_CUSTOMER_BUY_GOODS_ONLINE        StkTransactionType = 2
+)
+
+// Enum value maps for StkTransactionType.
+var (
+	StkTransactionType_name = map[int32]string{
+		0: "STK_TRANSACTION_TYPE_UNSPECIFIED",
+		1: "CUSTOMER_PAYBILL_ONLINE",
+		2: "CUSTOMER_BUY_GOODS_ONLINE",
+	}
+	StkTransactionType_value = map[string]int32{
+		"STK_TRANSACTION_TYPE_UNSPECIFIED": 0,
+		"CUSTOMER_PAYBILL_ONLINE":          1,
+		"CUSTOMER_BUY_GOODS_ONLINE":        2,
+	}
+)
+
+func (x StkTransactionType) Enum() *StkTransactionType {
+	p := new(StkTransactionType)
+	*p = x
+	return p
+}
+
+func (x StkTransactionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StkTransactionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[1].Descriptor()
+}
+
+func (StkTransactionType) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[1]
+}
+
+func (x StkTransactionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StkTransactionType.Descriptor instead.
+func (StkTransactionType) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{1}
+}
+
+type StkEventSource int32
+
+const (
+	StkEventSource_STK_SOURCE_UNSPECIFIED     StkEventSource = 0
+	StkEventSource_STK_SOURCE_INITIATE        StkEventSource = 1
+	StkEventSource_STK_SOURCE_CALLBACK        StkEventSource = 2
+	StkEventSource_STK_SOURCE_QUERY           StkEventSource = 3
+	StkEventSource_STK_SOURCE_MANUAL          StkEventSource = 4
+	StkEventSource_STK_SOURCE_PROCESS_CHANNEL StkEventSource = 5
+	StkEventSource_STK_SOURCE_RECONCILIATION  StkEventSource = 6
+)
+
+// Enum value maps for StkEventSource.
+var (
+	StkEventSource_name = map[int32]string{
+		0: "STK_SOURCE_UNSPECIFIED",
+		1: "STK_SOURCE_INITIATE",
+		2: "STK_SOURCE_CALLBACK",
+		3: "STK_SOURCE_QUERY",
+		4: "STK_SOURCE_MANUAL",
+		5: "STK_SOURCE_PROCESS_CHANNEL",
+		6: "STK_SOURCE_RECONCILIATION",
+	}
+	StkEventSource_value = map[string]int32{
+		"STK_SOURCE_UNSPECIFIED":     0,
+		"STK_SOURCE_INITIATE":        1,
+		"STK_SOURCE_CALLBACK":        2,
+		"STK_SOURCE_QUERY":           3,
+		"STK_SOURCE_MANUAL":          4,
+		"STK_SOURCE_PROCESS_CHANNEL": 5,
+		"STK_SOURCE_RECONCILIATION":  6,
+	}
+)
+
+func (x StkEventSource) Enum() *StkEventSource {
+	p := new(StkEventSource)
+	*p = x
+	return p
+}
+
+func (x StkEventSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StkEventSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[2].Descriptor()
+}
+
+func (StkEventSource) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[2]
+}
+
+func (x StkEventSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StkEventSource.Descriptor instead.
+func (StkEventSource) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{2}
+}
+
 type StkOrderField int32
 
 const (
@@ -120,11 +232,11 @@ func (x StkOrderField) String() string {
 }
 
 func (StkOrderField) Descriptor() protoreflect.EnumDescriptor {
-	return file_stk_v1_proto_enumTypes[1].Descriptor()
+	return file_stk_v1_proto_enumTypes[3].Descriptor()
 }
 
 func (StkOrderField) Type() protoreflect.EnumType {
-	return &file_stk_v1_proto_enumTypes[1]
+	return &file_stk_v1_proto_enumTypes[3]
 }
 
 func (x StkOrderField) Number() protoreflect.EnumNumber {
@@ -133,7 +245,7 @@ func (x StkOrderField) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StkOrderField.Descriptor instead.
 func (StkOrderField) EnumDescriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{1}
+	return file_stk_v1_proto_rawDescGZIP(), []int{3}
 }
 
 type StkProcessedState int32
@@ -169,11 +281,11 @@ func (x StkProcessedState) String() string {
 }
 
 func (StkProcessedState) Descriptor() protoreflect.EnumDescriptor {
-	return file_stk_v1_proto_enumTypes[2].Descriptor()
+	return file_stk_v1_proto_enumTypes[4].Descriptor()
 }
 
 func (StkProcessedState) Type() protoreflect.EnumType {
-	return &file_stk_v1_proto_enumTypes[2]
+	return &file_stk_v1_proto_enumTypes[4]
 }
 
 func (x StkProcessedState) Number() protoreflect.EnumNumber {
@@ -182,7 +294,102 @@ func (x StkProcessedState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StkProcessedState.Descriptor instead.
 func (StkProcessedState) EnumDescriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{2}
+	return file_stk_v1_proto_rawDescGZIP(), []int{4}
+}
+
+type StkSucceededState int32
+
+const (
+	StkSucceededState_STK_SUCCEEDED_STATE_UNSPECIFIED StkSucceededState = 0
+	StkSucceededState_STK_SUCCEEDED                   StkSucceededState = 1
+	StkSucceededState_STK_NOT_SUCCEEDED               StkSucceededState = 2
+)
+
+// Enum value maps for StkSucceededState.
+var (
+	StkSucceededState_name = map[int32]string{
+		0: "STK_SUCCEEDED_STATE_UNSPECIFIED",
+		1: "STK_SUCCEEDED",
+		2: "STK_NOT_SUCCEEDED",
+	}
+	StkSucceededState_value = map[string]int32{
+		"STK_SUCCEEDED_STATE_UNSPECIFIED": 0,
+		"STK_SUCCEEDED":                   1,
+		"STK_NOT_SUCCEEDED":               2,
+	}
+)
+
+func (x StkSucceededState) Enum() *StkSucceededState {
+	p := new(StkSucceededState)
+	*p = x
+	return p
+}
+
+func (x StkSucceededState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StkSucceededState) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[5].Descriptor()
+}
+
+func (StkSucceededState) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[5]
+}
+
+func (x StkSucceededState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StkSucceededState.Descriptor instead.
+func (StkSucceededState) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{5}
+}
+
+type StkSortOrder int32
+
+const (
+	StkSortOrder_STK_SORT_DESCENDING StkSortOrder = 0
+	StkSortOrder_STK_SORT_ASCENDING  StkSortOrder = 1
+)
+
+// Enum value maps for StkSortOrder.
+var (
+	StkSortOrder_name = map[int32]string{
+		0: "STK_SORT_DESCENDING",
+		1: "STK_SORT_ASCENDING",
+	}
+	StkSortOrder_value = map[string]int32{
+		"STK_SORT_DESCENDING": 0,
+		"STK_SORT_ASCENDING":  1,
+	}
+)
+
+func (x StkSortOrder) Enum() *StkSortOrder {
+	p := new(StkSortOrder)
+	*p = x
+	return p
+}
+
+func (x StkSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StkSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[6].Descriptor()
+}
+
+func (StkSortOrder) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[6]
+}
+
+func (x StkSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StkSortOrder.Descriptor instead.
+func (StkSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{6}
 }
 
 type ListStkTransactionsView int32
@@ -218,11 +425,11 @@ func (x ListStkTransactionsView) String() string {
 }
 
 func (ListStkTransactionsView) Descriptor() protoreflect.EnumDescriptor {
-	return file_stk_v1_proto_enumTypes[3].Descriptor()
+	return file_stk_v1_proto_enumTypes[7].Descriptor()
 }
 
 func (ListStkTransactionsView) Type() protoreflect.EnumType {
-	return &file_stk_v1_proto_enumTypes[3]
+	return &file_stk_v1_proto_enumTypes[7]
 }
 
 func (x ListStkTransactionsView) Number() protoreflect.EnumNumber {
@@ -231,332 +438,544 @@ func (x ListStkTransactionsView) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListStkTransactionsView.Descriptor instead.
 func (ListStkTransactionsView) EnumDescriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{3}
+	return file_stk_v1_proto_rawDescGZIP(), []int{7}
 }
 
-type StkTransaction struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type StkTransactionEventType int32
 
-	TransactionId              uint64    `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	InitiatorId                string    `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	InitiatorCustomerReference string    `protobuf:"bytes,3,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
-	InitiatorCustomerNames     string    `protobuf:"bytes,4,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
-	ShortCode                  string    `protobuf:"bytes,5,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	AccountReference           string    `protobuf:"bytes,6,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
-	Amount                     string    `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
-	PhoneNumber                string    `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	TransactionDesc            string    `protobuf:"bytes,9,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
-	MerchantRequestId          string    `protobuf:"bytes,10,opt,name=merchant_request_id,json=merchantRequestId,proto3" json:"merchant_request_id,omitempty"`
-	CheckoutRequestId          string    `protobuf:"bytes,11,opt,name=checkout_request_id,json=checkoutRequestId,proto3" json:"checkout_request_id,omitempty"`
-	StkResponseDescription     string    `protobuf:"bytes,12,opt,name=stk_response_description,json=stkResponseDescription,proto3" json:"stk_response_description,omitempty"`
-	StkResponseCode            string    `protobuf:"bytes,13,opt,name=stk_response_code,json=stkResponseCode,proto3" json:"stk_response_code,omitempty"`
-	StkResultCode              string    `protobuf:"bytes,14,opt,name=stk_result_code,json=stkResultCode,proto3" json:"stk_result_code,omitempty"`
-	StkResultDesc              string    `protobuf:"bytes,15,opt,name=stk_result_desc,json=stkResultDesc,proto3" json:"stk_result_desc,omitempty"`
-	MpesaReceiptId             string    `protobuf:"bytes,16,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
-	Balance                    string    `protobuf:"bytes,17,opt,name=balance,proto3" json:"balance,omitempty"`
-	Status                     StkStatus `protobuf:"varint,18,opt,name=status,proto3,enum=gidyon.mpesastk.StkStatus" json:"status,omitempty"`
-	Source                     string    `protobuf:"bytes,19,opt,name=source,proto3" json:"source,omitempty"`
-	Tag                        string    `protobuf:"bytes,20,opt,name=tag,proto3" json:"tag,omitempty"`
-	Succeeded                  bool      `protobuf:"varint,21,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
-	Processed                  bool      `protobuf:"varint,22,opt,name=processed,proto3" json:"processed,omitempty"`
-	TransactionTimestamp       int64     `protobuf:"varint,23,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
-	CreateTimestamp            int64     `protobuf:"varint,24,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
-}
+const (
+	StkTransactionEventType_STK_EVENT_TYPE_UNSPECIFIED StkTransactionEventType = 0
+	StkTransactionEventType_STK_TRANSACTION_CREATED    StkTransactionEventType = 1
+	StkTransactionEventType_STK_TRANSACTION_UPDATED    StkTransactionEventType = 2
+)
 
-func (x *StkTransaction) Reset() {
-	*x = StkTransaction{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for StkTransactionEventType.
+var (
+	StkTransactionEventType_name = map[int32]string{
+		0: "STK_EVENT_TYPE_UNSPECIFIED",
+		1: "STK_TRANSACTION_CREATED",
+		2: "STK_TRANSACTION_UPDATED",
 	}
-}
+	StkTransactionEventType_value = map[string]int32{
+		"STK_EVENT_TYPE_UNSPECIFIED": 0,
+		"STK_TRANSACTION_CREATED":    1,
+		"STK_TRANSACTION_UPDATED":    2,
+	}
+)
 
-func (x *StkTransaction) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x StkTransactionEventType) Enum() *StkTransactionEventType {
+	p := new(StkTransactionEventType)
+	*p = x
+	return p
 }
 
-func (*StkTransaction) ProtoMessage() {}
+func (x StkTransactionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *StkTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (StkTransactionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[8].Descriptor()
 }
 
-// Deprecated: Use StkTransaction.ProtoReflect.Descriptor instead.
-func (*StkTransaction) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{0}
+func (StkTransactionEventType) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[8]
 }
 
-func (x *StkTransaction) GetTransactionId() uint64 {
-	if x != nil {
-		return x.TransactionId
-	}
-	return 0
+func (x StkTransactionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *StkTransaction) GetInitiatorId() string {
-	if x != nil {
-		return x.InitiatorId
-	}
-	return ""
+// Deprecated: Use StkTransactionEventType.Descriptor instead.
+func (StkTransactionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *StkTransaction) GetInitiatorCustomerReference() string {
-	if x != nil {
-		return x.InitiatorCustomerReference
+type StkCallbackState int32
+
+const (
+	StkCallbackState_STK_CALLBACK_STATE_UNSPECIFIED StkCallbackState = 0
+	StkCallbackState_STK_CALLBACK_RECEIVED          StkCallbackState = 1
+	StkCallbackState_STK_CALLBACK_PROCESSED         StkCallbackState = 2
+	StkCallbackState_STK_CALLBACK_DUPLICATE         StkCallbackState = 3
+	StkCallbackState_STK_CALLBACK_FAILED            StkCallbackState = 4
+	StkCallbackState_STK_CALLBACK_REJECTED          StkCallbackState = 5
+)
+
+// Enum value maps for StkCallbackState.
+var (
+	StkCallbackState_name = map[int32]string{
+		0: "STK_CALLBACK_STATE_UNSPECIFIED",
+		1: "STK_CALLBACK_RECEIVED",
+		2: "STK_CALLBACK_PROCESSED",
+		3: "STK_CALLBACK_DUPLICATE",
+		4: "STK_CALLBACK_FAILED",
+		5: "STK_CALLBACK_REJECTED",
 	}
-	return ""
+	StkCallbackState_value = map[string]int32{
+		"STK_CALLBACK_STATE_UNSPECIFIED": 0,
+		"STK_CALLBACK_RECEIVED":          1,
+		"STK_CALLBACK_PROCESSED":         2,
+		"STK_CALLBACK_DUPLICATE":         3,
+		"STK_CALLBACK_FAILED":            4,
+		"STK_CALLBACK_REJECTED":          5,
+	}
+)
+
+func (x StkCallbackState) Enum() *StkCallbackState {
+	p := new(StkCallbackState)
+	*p = x
+	return p
 }
 
-func (x *StkTransaction) GetInitiatorCustomerNames() string {
-	if x != nil {
-		return x.InitiatorCustomerNames
-	}
-	return ""
+func (x StkCallbackState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *StkTransaction) GetShortCode() string {
-	if x != nil {
-		return x.ShortCode
-	}
-	return ""
+func (StkCallbackState) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[9].Descriptor()
 }
 
-func (x *StkTransaction) GetAccountReference() string {
-	if x != nil {
-		return x.AccountReference
-	}
-	return ""
+func (StkCallbackState) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[9]
 }
 
-func (x *StkTransaction) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+func (x StkCallbackState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *StkTransaction) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
+// Deprecated: Use StkCallbackState.Descriptor instead.
+func (StkCallbackState) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *StkTransaction) GetTransactionDesc() string {
-	if x != nil {
-		return x.TransactionDesc
+type WebhookDeliveryState int32
+
+const (
+	WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_UNSPECIFIED WebhookDeliveryState = 0
+	WebhookDeliveryState_WEBHOOK_DELIVERY_PENDING           WebhookDeliveryState = 1
+	WebhookDeliveryState_WEBHOOK_DELIVERY_SUCCEEDED         WebhookDeliveryState = 2
+	WebhookDeliveryState_WEBHOOK_DELIVERY_FAILED            WebhookDeliveryState = 3
+)
+
+// Enum value maps for WebhookDeliveryState.
+var (
+	WebhookDeliveryState_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATE_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_PENDING",
+		2: "WEBHOOK_DELIVERY_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_FAILED",
 	}
-	return ""
+	WebhookDeliveryState_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATE_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_PENDING":           1,
+		"WEBHOOK_DELIVERY_SUCCEEDED":         2,
+		"WEBHOOK_DELIVERY_FAILED":            3,
+	}
+)
+
+func (x WebhookDeliveryState) Enum() *WebhookDeliveryState {
+	p := new(WebhookDeliveryState)
+	*p = x
+	return p
 }
 
-func (x *StkTransaction) GetMerchantRequestId() string {
-	if x != nil {
-		return x.MerchantRequestId
-	}
-	return ""
+func (x WebhookDeliveryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *StkTransaction) GetCheckoutRequestId() string {
-	if x != nil {
-		return x.CheckoutRequestId
-	}
-	return ""
+func (WebhookDeliveryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[10].Descriptor()
 }
 
-func (x *StkTransaction) GetStkResponseDescription() string {
-	if x != nil {
-		return x.StkResponseDescription
-	}
-	return ""
+func (WebhookDeliveryState) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[10]
 }
 
-func (x *StkTransaction) GetStkResponseCode() string {
-	if x != nil {
-		return x.StkResponseCode
-	}
-	return ""
+func (x WebhookDeliveryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *StkTransaction) GetStkResultCode() string {
-	if x != nil {
-		return x.StkResultCode
-	}
-	return ""
+// Deprecated: Use WebhookDeliveryState.Descriptor instead.
+func (WebhookDeliveryState) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *StkTransaction) GetStkResultDesc() string {
-	if x != nil {
-		return x.StkResultDesc
+type OutboxEntryState int32
+
+const (
+	OutboxEntryState_OUTBOX_ENTRY_STATE_UNSPECIFIED OutboxEntryState = 0
+	OutboxEntryState_OUTBOX_PENDING                 OutboxEntryState = 1
+	OutboxEntryState_OUTBOX_DISPATCHED              OutboxEntryState = 2
+	OutboxEntryState_OUTBOX_DEAD_LETTERED           OutboxEntryState = 3
+)
+
+// Enum value maps for OutboxEntryState.
+var (
+	OutboxEntryState_name = map[int32]string{
+		0: "OUTBOX_ENTRY_STATE_UNSPECIFIED",
+		1: "OUTBOX_PENDING",
+		2: "OUTBOX_DISPATCHED",
+		3: "OUTBOX_DEAD_LETTERED",
 	}
-	return ""
+	OutboxEntryState_value = map[string]int32{
+		"OUTBOX_ENTRY_STATE_UNSPECIFIED": 0,
+		"OUTBOX_PENDING":                 1,
+		"OUTBOX_DISPATCHED":              2,
+		"OUTBOX_DEAD_LETTERED":           3,
+	}
+)
+
+func (x OutboxEntryState) Enum() *OutboxEntryState {
+	p := new(OutboxEntryState)
+	*p = x
+	return p
 }
 
-func (x *StkTransaction) GetMpesaReceiptId() string {
-	if x != nil {
-		return x.MpesaReceiptId
-	}
-	return ""
+func (x OutboxEntryState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *StkTransaction) GetBalance() string {
-	if x != nil {
-		return x.Balance
-	}
-	return ""
+func (OutboxEntryState) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[11].Descriptor()
 }
 
-func (x *StkTransaction) GetStatus() StkStatus {
-	if x != nil {
-		return x.Status
-	}
-	return StkStatus_STK_STATUS_UNKNOWN
+func (OutboxEntryState) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[11]
 }
 
-func (x *StkTransaction) GetSource() string {
-	if x != nil {
-		return x.Source
-	}
-	return ""
+func (x OutboxEntryState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *StkTransaction) GetTag() string {
-	if x != nil {
-		return x.Tag
-	}
-	return ""
+// Deprecated: Use OutboxEntryState.Descriptor instead.
+func (OutboxEntryState) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *StkTransaction) GetSucceeded() bool {
-	if x != nil {
-		return x.Succeeded
+type DeadLetterKind int32
+
+const (
+	DeadLetterKind_DEAD_LETTER_KIND_UNSPECIFIED DeadLetterKind = 0
+	DeadLetterKind_DEAD_LETTER_PUBLISH          DeadLetterKind = 1
+	DeadLetterKind_DEAD_LETTER_PROCESS_REQUEST  DeadLetterKind = 2
+)
+
+// Enum value maps for DeadLetterKind.
+var (
+	DeadLetterKind_name = map[int32]string{
+		0: "DEAD_LETTER_KIND_UNSPECIFIED",
+		1: "DEAD_LETTER_PUBLISH",
+		2: "DEAD_LETTER_PROCESS_REQUEST",
 	}
-	return false
+	DeadLetterKind_value = map[string]int32{
+		"DEAD_LETTER_KIND_UNSPECIFIED": 0,
+		"DEAD_LETTER_PUBLISH":          1,
+		"DEAD_LETTER_PROCESS_REQUEST":  2,
+	}
+)
+
+func (x DeadLetterKind) Enum() *DeadLetterKind {
+	p := new(DeadLetterKind)
+	*p = x
+	return p
 }
 
-func (x *StkTransaction) GetProcessed() bool {
-	if x != nil {
-		return x.Processed
-	}
-	return false
+func (x DeadLetterKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *StkTransaction) GetTransactionTimestamp() int64 {
-	if x != nil {
-		return x.TransactionTimestamp
-	}
-	return 0
+func (DeadLetterKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[12].Descriptor()
 }
 
-func (x *StkTransaction) GetCreateTimestamp() int64 {
-	if x != nil {
-		return x.CreateTimestamp
-	}
-	return 0
+func (DeadLetterKind) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[12]
 }
 
-type PublishInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x DeadLetterKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	OnlyOnSuccess bool              `protobuf:"varint,1,opt,name=only_on_success,json=onlyOnSuccess,proto3" json:"only_on_success,omitempty"`
-	ChannelName   string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
-	Payload       map[string]string `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// Deprecated: Use DeadLetterKind.Descriptor instead.
+func (DeadLetterKind) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PublishInfo) Reset() {
-	*x = PublishInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type StkStatsInterval int32
+
+const (
+	StkStatsInterval_STK_STATS_INTERVAL_UNSPECIFIED StkStatsInterval = 0
+	StkStatsInterval_STK_STATS_HOUR                 StkStatsInterval = 1
+	StkStatsInterval_STK_STATS_DAY                  StkStatsInterval = 2
+	StkStatsInterval_STK_STATS_MONTH                StkStatsInterval = 3
+)
+
+// Enum value maps for StkStatsInterval.
+var (
+	StkStatsInterval_name = map[int32]string{
+		0: "STK_STATS_INTERVAL_UNSPECIFIED",
+		1: "STK_STATS_HOUR",
+		2: "STK_STATS_DAY",
+		3: "STK_STATS_MONTH",
 	}
+	StkStatsInterval_value = map[string]int32{
+		"STK_STATS_INTERVAL_UNSPECIFIED": 0,
+		"STK_STATS_HOUR":                 1,
+		"STK_STATS_DAY":                  2,
+		"STK_STATS_MONTH":                3,
+	}
+)
+
+func (x StkStatsInterval) Enum() *StkStatsInterval {
+	p := new(StkStatsInterval)
+	*p = x
+	return p
 }
 
-func (x *PublishInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x StkStatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*PublishInfo) ProtoMessage() {}
+func (StkStatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[13].Descriptor()
+}
 
-func (x *PublishInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (StkStatsInterval) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[13]
 }
 
-// Deprecated: Use PublishInfo.ProtoReflect.Descriptor instead.
-func (*PublishInfo) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{1}
+func (x StkStatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PublishInfo) GetOnlyOnSuccess() bool {
-	if x != nil {
-		return x.OnlyOnSuccess
+// Deprecated: Use StkStatsInterval.Descriptor instead.
+func (StkStatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{13}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_CSV                ExportFormat = 1
+	ExportFormat_EXPORT_NDJSON             ExportFormat = 2
+	ExportFormat_EXPORT_XLSX               ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_CSV",
+		2: "EXPORT_NDJSON",
+		3: "EXPORT_XLSX",
 	}
-	return false
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_CSV":                1,
+		"EXPORT_NDJSON":             2,
+		"EXPORT_XLSX":               3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
 }
 
-func (x *PublishInfo) GetChannelName() string {
-	if x != nil {
-		return x.ChannelName
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[14].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[14]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{14}
+}
+
+type ExportJobState int32
+
+const (
+	ExportJobState_EXPORT_JOB_STATE_UNSPECIFIED ExportJobState = 0
+	ExportJobState_EXPORT_JOB_PENDING           ExportJobState = 1
+	ExportJobState_EXPORT_JOB_RUNNING           ExportJobState = 2
+	ExportJobState_EXPORT_JOB_COMPLETED         ExportJobState = 3
+	ExportJobState_EXPORT_JOB_FAILED            ExportJobState = 4
+	// The file was deleted after the export retention period
+	ExportJobState_EXPORT_JOB_EXPIRED ExportJobState = 5
+)
+
+// Enum value maps for ExportJobState.
+var (
+	ExportJobState_name = map[int32]string{
+		0: "EXPORT_JOB_STATE_UNSPECIFIED",
+		1: "EXPORT_JOB_PENDING",
+		2: "EXPORT_JOB_RUNNING",
+		3: "EXPORT_JOB_COMPLETED",
+		4: "EXPORT_JOB_FAILED",
+		5: "EXPORT_JOB_EXPIRED",
 	}
-	return ""
+	ExportJobState_value = map[string]int32{
+		"EXPORT_JOB_STATE_UNSPECIFIED": 0,
+		"EXPORT_JOB_PENDING":           1,
+		"EXPORT_JOB_RUNNING":           2,
+		"EXPORT_JOB_COMPLETED":         3,
+		"EXPORT_JOB_FAILED":            4,
+		"EXPORT_JOB_EXPIRED":           5,
+	}
+)
+
+func (x ExportJobState) Enum() *ExportJobState {
+	p := new(ExportJobState)
+	*p = x
+	return p
 }
 
-func (x *PublishInfo) GetPayload() map[string]string {
-	if x != nil {
-		return x.Payload
+func (x ExportJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[15].Descriptor()
+}
+
+func (ExportJobState) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[15]
+}
+
+func (x ExportJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportJobState.Descriptor instead.
+func (ExportJobState) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{15}
+}
+
+type ReconciliationCategory int32
+
+const (
+	ReconciliationCategory_RECONCILIATION_CATEGORY_UNSPECIFIED ReconciliationCategory = 0
+	ReconciliationCategory_RECONCILIATION_MATCHED              ReconciliationCategory = 1
+	// Payment in the statement has no stk transaction
+	ReconciliationCategory_RECONCILIATION_MISSING_IN_DB ReconciliationCategory = 2
+	// Successful stk transaction is not in the statement
+	ReconciliationCategory_RECONCILIATION_MISSING_IN_STATEMENT ReconciliationCategory = 3
+	ReconciliationCategory_RECONCILIATION_AMOUNT_MISMATCH      ReconciliationCategory = 4
+	// Payment in the statement belongs to a stk transaction that is not successful
+	ReconciliationCategory_RECONCILIATION_STATUS_MISMATCH ReconciliationCategory = 5
+)
+
+// Enum value maps for ReconciliationCategory.
+var (
+	ReconciliationCategory_name = map[int32]string{
+		0: "RECONCILIATION_CATEGORY_UNSPECIFIED",
+		1: "RECONCILIATION_MATCHED",
+		2: "RECONCILIATION_MISSING_IN_DB",
+		3: "RECONCILIATION_MISSING_IN_STATEMENT",
+		4: "RECONCILIATION_AMOUNT_MISMATCH",
+		5: "RECONCILIATION_STATUS_MISMATCH",
 	}
-	return nil
+	ReconciliationCategory_value = map[string]int32{
+		"RECONCILIATION_CATEGORY_UNSPECIFIED": 0,
+		"RECONCILIATION_MATCHED":              1,
+		"RECONCILIATION_MISSING_IN_DB":        2,
+		"RECONCILIATION_MISSING_IN_STATEMENT": 3,
+		"RECONCILIATION_AMOUNT_MISMATCH":      4,
+		"RECONCILIATION_STATUS_MISMATCH":      5,
+	}
+)
+
+func (x ReconciliationCategory) Enum() *ReconciliationCategory {
+	p := new(ReconciliationCategory)
+	*p = x
+	return p
 }
 
-type TriggerSTKRequest struct {
+func (x ReconciliationCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReconciliationCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_stk_v1_proto_enumTypes[16].Descriptor()
+}
+
+func (ReconciliationCategory) Type() protoreflect.EnumType {
+	return &file_stk_v1_proto_enumTypes[16]
+}
+
+func (x ReconciliationCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReconciliationCategory.Descriptor instead.
+func (ReconciliationCategory) EnumDescriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{16}
+}
+
+type StkTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone            string  `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Amount           float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	AccountReference string  `protobuf:"bytes,3,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
-	TransactionDesc  string  `protobuf:"bytes,4,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
-	WaitDur          int64   `protobuf:"varint,5,opt,name=wait_dur,json=waitDur,proto3" json:"wait_dur,omitempty"`
+	TransactionId              uint64             `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	InitiatorId                string             `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	InitiatorCustomerReference string             `protobuf:"bytes,3,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
+	InitiatorCustomerNames     string             `protobuf:"bytes,4,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
+	ShortCode                  string             `protobuf:"bytes,5,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	AccountReference           string             `protobuf:"bytes,6,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
+	Amount                     string             `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	PhoneNumber                string             `protobuf:"bytes,8,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	TransactionDesc            string             `protobuf:"bytes,9,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
+	MerchantRequestId          string             `protobuf:"bytes,10,opt,name=merchant_request_id,json=merchantRequestId,proto3" json:"merchant_request_id,omitempty"`
+	CheckoutRequestId          string             `protobuf:"bytes,11,opt,name=checkout_request_id,json=checkoutRequestId,proto3" json:"checkout_request_id,omitempty"`
+	StkResponseDescription     string             `protobuf:"bytes,12,opt,name=stk_response_description,json=stkResponseDescription,proto3" json:"stk_response_description,omitempty"`
+	StkResponseCode            string             `protobuf:"bytes,13,opt,name=stk_response_code,json=stkResponseCode,proto3" json:"stk_response_code,omitempty"`
+	StkResultCode              string             `protobuf:"bytes,14,opt,name=stk_result_code,json=stkResultCode,proto3" json:"stk_result_code,omitempty"`
+	StkResultDesc              string             `protobuf:"bytes,15,opt,name=stk_result_desc,json=stkResultDesc,proto3" json:"stk_result_desc,omitempty"`
+	MpesaReceiptId             string             `protobuf:"bytes,16,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	Balance                    string             `protobuf:"bytes,17,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status                     StkStatus          `protobuf:"varint,18,opt,name=status,proto3,enum=gidyon.mpesastk.StkStatus" json:"status,omitempty"`
+	Source                     string             `protobuf:"bytes,19,opt,name=source,proto3" json:"source,omitempty"`
+	Tag                        string             `protobuf:"bytes,20,opt,name=tag,proto3" json:"tag,omitempty"`
+	Succeeded                  bool               `protobuf:"varint,21,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Processed                  bool               `protobuf:"varint,22,opt,name=processed,proto3" json:"processed,omitempty"`
+	TransactionTimestamp       int64              `protobuf:"varint,23,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
+	CreateTimestamp            int64              `protobuf:"varint,24,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+	TransactionType            StkTransactionType `protobuf:"varint,25,opt,name=transaction_type,json=transactionType,proto3,enum=gidyon.mpesastk.StkTransactionType" json:"transaction_type,omitempty"`
+	CallbackExtras             map[string]string  `protobuf:"bytes,26,rep,name=callback_extras,json=callbackExtras,proto3" json:"callback_extras,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UpdateTimestamp            int64              `protobuf:"varint,27,opt,name=update_timestamp,json=updateTimestamp,proto3" json:"update_timestamp,omitempty"`
 }
 
-func (x *TriggerSTKRequest) Reset() {
-	*x = TriggerSTKRequest{}
+func (x *StkTransaction) Reset() {
+	*x = StkTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[2]
+		mi := &file_stk_v1_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerSTKRequest) String() string {
+func (x *StkTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerSTKRequest) ProtoMessage() {}
+func (*StkTransaction) ProtoMessage() {}
 
-func (x *TriggerSTKRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[2]
+func (x *StkTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,278 +986,229 @@ func (x *TriggerSTKRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerSTKRequest.ProtoReflect.Descriptor instead.
-func (*TriggerSTKRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use StkTransaction.ProtoReflect.Descriptor instead.
+func (*StkTransaction) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *TriggerSTKRequest) GetPhone() string {
+func (x *StkTransaction) GetTransactionId() uint64 {
 	if x != nil {
-		return x.Phone
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *StkTransaction) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
 	}
 	return ""
 }
 
-func (x *TriggerSTKRequest) GetAmount() float64 {
+func (x *StkTransaction) GetInitiatorCustomerReference() string {
 	if x != nil {
-		return x.Amount
+		return x.InitiatorCustomerReference
 	}
-	return 0
+	return ""
 }
 
-func (x *TriggerSTKRequest) GetAccountReference() string {
+func (x *StkTransaction) GetInitiatorCustomerNames() string {
 	if x != nil {
-		return x.AccountReference
+		return x.InitiatorCustomerNames
 	}
 	return ""
 }
 
-func (x *TriggerSTKRequest) GetTransactionDesc() string {
+func (x *StkTransaction) GetShortCode() string {
 	if x != nil {
-		return x.TransactionDesc
+		return x.ShortCode
 	}
 	return ""
 }
 
-func (x *TriggerSTKRequest) GetWaitDur() int64 {
+func (x *StkTransaction) GetAccountReference() string {
 	if x != nil {
-		return x.WaitDur
+		return x.AccountReference
 	}
-	return 0
+	return ""
 }
 
-type TriggerSTKResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Phone            string  `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Amount           float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	AccountReference string  `protobuf:"bytes,3,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
-	TransactionDesc  string  `protobuf:"bytes,4,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
-	WaitDur          int64   `protobuf:"varint,5,opt,name=wait_dur,json=waitDur,proto3" json:"wait_dur,omitempty"`
-}
-
-func (x *TriggerSTKResponse) Reset() {
-	*x = TriggerSTKResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StkTransaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
+	return ""
 }
 
-func (x *TriggerSTKResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TriggerSTKResponse) ProtoMessage() {}
-
-func (x *TriggerSTKResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StkTransaction) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TriggerSTKResponse.ProtoReflect.Descriptor instead.
-func (*TriggerSTKResponse) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{3}
+	return ""
 }
 
-func (x *TriggerSTKResponse) GetPhone() string {
+func (x *StkTransaction) GetTransactionDesc() string {
 	if x != nil {
-		return x.Phone
+		return x.TransactionDesc
 	}
 	return ""
 }
 
-func (x *TriggerSTKResponse) GetAmount() float64 {
+func (x *StkTransaction) GetMerchantRequestId() string {
 	if x != nil {
-		return x.Amount
+		return x.MerchantRequestId
 	}
-	return 0
+	return ""
 }
 
-func (x *TriggerSTKResponse) GetAccountReference() string {
+func (x *StkTransaction) GetCheckoutRequestId() string {
 	if x != nil {
-		return x.AccountReference
+		return x.CheckoutRequestId
 	}
 	return ""
 }
 
-func (x *TriggerSTKResponse) GetTransactionDesc() string {
+func (x *StkTransaction) GetStkResponseDescription() string {
 	if x != nil {
-		return x.TransactionDesc
+		return x.StkResponseDescription
 	}
 	return ""
 }
 
-func (x *TriggerSTKResponse) GetWaitDur() int64 {
+func (x *StkTransaction) GetStkResponseCode() string {
 	if x != nil {
-		return x.WaitDur
+		return x.StkResponseCode
 	}
-	return 0
-}
-
-type InitiateSTKRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InitiatorId                   string       `protobuf:"bytes,1,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	InitiatorTransactionReference string       `protobuf:"bytes,2,opt,name=initiator_transaction_reference,json=initiatorTransactionReference,proto3" json:"initiator_transaction_reference,omitempty"`
-	InitiatorCustomerReference    string       `protobuf:"bytes,3,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
-	InitiatorCustomerNames        string       `protobuf:"bytes,4,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
-	Phone                         string       `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Amount                        float64      `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	ShortCode                     string       `protobuf:"bytes,7,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	AccountReference              string       `protobuf:"bytes,8,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
-	TransactionDesc               string       `protobuf:"bytes,9,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
-	Publish                       bool         `protobuf:"varint,10,opt,name=publish,proto3" json:"publish,omitempty"`
-	PublishMessage                *PublishInfo `protobuf:"bytes,11,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
+	return ""
 }
 
-func (x *InitiateSTKRequest) Reset() {
-	*x = InitiateSTKRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StkTransaction) GetStkResultCode() string {
+	if x != nil {
+		return x.StkResultCode
 	}
+	return ""
 }
 
-func (x *InitiateSTKRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*InitiateSTKRequest) ProtoMessage() {}
-
-func (x *InitiateSTKRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StkTransaction) GetStkResultDesc() string {
+	if x != nil {
+		return x.StkResultDesc
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use InitiateSTKRequest.ProtoReflect.Descriptor instead.
-func (*InitiateSTKRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{4}
+	return ""
 }
 
-func (x *InitiateSTKRequest) GetInitiatorId() string {
+func (x *StkTransaction) GetMpesaReceiptId() string {
 	if x != nil {
-		return x.InitiatorId
+		return x.MpesaReceiptId
 	}
 	return ""
 }
 
-func (x *InitiateSTKRequest) GetInitiatorTransactionReference() string {
+func (x *StkTransaction) GetBalance() string {
 	if x != nil {
-		return x.InitiatorTransactionReference
+		return x.Balance
 	}
 	return ""
 }
 
-func (x *InitiateSTKRequest) GetInitiatorCustomerReference() string {
+func (x *StkTransaction) GetStatus() StkStatus {
 	if x != nil {
-		return x.InitiatorCustomerReference
+		return x.Status
 	}
-	return ""
+	return StkStatus_STK_STATUS_UNKNOWN
 }
 
-func (x *InitiateSTKRequest) GetInitiatorCustomerNames() string {
+func (x *StkTransaction) GetSource() string {
 	if x != nil {
-		return x.InitiatorCustomerNames
+		return x.Source
 	}
 	return ""
 }
 
-func (x *InitiateSTKRequest) GetPhone() string {
+func (x *StkTransaction) GetTag() string {
 	if x != nil {
-		return x.Phone
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *InitiateSTKRequest) GetAmount() float64 {
+func (x *StkTransaction) GetSucceeded() bool {
 	if x != nil {
-		return x.Amount
+		return x.Succeeded
 	}
-	return 0
+	return false
 }
 
-func (x *InitiateSTKRequest) GetShortCode() string {
+func (x *StkTransaction) GetProcessed() bool {
 	if x != nil {
-		return x.ShortCode
+		return x.Processed
 	}
-	return ""
+	return false
 }
 
-func (x *InitiateSTKRequest) GetAccountReference() string {
+func (x *StkTransaction) GetTransactionTimestamp() int64 {
 	if x != nil {
-		return x.AccountReference
+		return x.TransactionTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *InitiateSTKRequest) GetTransactionDesc() string {
+func (x *StkTransaction) GetCreateTimestamp() int64 {
 	if x != nil {
-		return x.TransactionDesc
+		return x.CreateTimestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *InitiateSTKRequest) GetPublish() bool {
+func (x *StkTransaction) GetTransactionType() StkTransactionType {
 	if x != nil {
-		return x.Publish
+		return x.TransactionType
 	}
-	return false
+	return StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-func (x *InitiateSTKRequest) GetPublishMessage() *PublishInfo {
+func (x *StkTransaction) GetCallbackExtras() map[string]string {
 	if x != nil {
-		return x.PublishMessage
+		return x.CallbackExtras
 	}
 	return nil
 }
 
-type InitiateSTKResponse struct {
+func (x *StkTransaction) GetUpdateTimestamp() int64 {
+	if x != nil {
+		return x.UpdateTimestamp
+	}
+	return 0
+}
+
+type PublishInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Progress bool   `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
-	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	OnlyOnSuccess bool              `protobuf:"varint,1,opt,name=only_on_success,json=onlyOnSuccess,proto3" json:"only_on_success,omitempty"`
+	ChannelName   string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Payload       map[string]string `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Url that receives the publish message as json signed with HMAC-SHA256
+	WebhookUrl string `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
 }
 
-func (x *InitiateSTKResponse) Reset() {
-	*x = InitiateSTKResponse{}
+func (x *PublishInfo) Reset() {
+	*x = PublishInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[5]
+		mi := &file_stk_v1_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InitiateSTKResponse) String() string {
+func (x *PublishInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitiateSTKResponse) ProtoMessage() {}
+func (*PublishInfo) ProtoMessage() {}
 
-func (x *InitiateSTKResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[5]
+func (x *PublishInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,51 +1219,68 @@ func (x *InitiateSTKResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitiateSTKResponse.ProtoReflect.Descriptor instead.
-func (*InitiateSTKResponse) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use PublishInfo.ProtoReflect.Descriptor instead.
+func (*PublishInfo) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *InitiateSTKResponse) GetProgress() bool {
+func (x *PublishInfo) GetOnlyOnSuccess() bool {
 	if x != nil {
-		return x.Progress
+		return x.OnlyOnSuccess
 	}
 	return false
 }
 
-func (x *InitiateSTKResponse) GetMessage() string {
+func (x *PublishInfo) GetChannelName() string {
 	if x != nil {
-		return x.Message
+		return x.ChannelName
 	}
 	return ""
 }
 
-type GetStkTransactionRequest struct {
+func (x *PublishInfo) GetPayload() map[string]string {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PublishInfo) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+type TriggerSTKRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransactionId  uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	MpesaReceiptId string `protobuf:"bytes,2,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	Phone            string  `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Amount           float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccountReference string  `protobuf:"bytes,3,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
+	TransactionDesc  string  `protobuf:"bytes,4,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
+	WaitDur          int64   `protobuf:"varint,5,opt,name=wait_dur,json=waitDur,proto3" json:"wait_dur,omitempty"`
 }
 
-func (x *GetStkTransactionRequest) Reset() {
-	*x = GetStkTransactionRequest{}
+func (x *TriggerSTKRequest) Reset() {
+	*x = TriggerSTKRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[6]
+		mi := &file_stk_v1_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStkTransactionRequest) String() string {
+func (x *TriggerSTKRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStkTransactionRequest) ProtoMessage() {}
+func (*TriggerSTKRequest) ProtoMessage() {}
 
-func (x *GetStkTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[6]
+func (x *TriggerSTKRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,50 +1291,75 @@ func (x *GetStkTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStkTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetStkTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TriggerSTKRequest.ProtoReflect.Descriptor instead.
+func (*TriggerSTKRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetStkTransactionRequest) GetTransactionId() uint64 {
+func (x *TriggerSTKRequest) GetPhone() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *TriggerSTKRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *GetStkTransactionRequest) GetMpesaReceiptId() string {
+func (x *TriggerSTKRequest) GetAccountReference() string {
 	if x != nil {
-		return x.MpesaReceiptId
+		return x.AccountReference
 	}
 	return ""
 }
 
-type CreateStkTransactionRequest struct {
+func (x *TriggerSTKRequest) GetTransactionDesc() string {
+	if x != nil {
+		return x.TransactionDesc
+	}
+	return ""
+}
+
+func (x *TriggerSTKRequest) GetWaitDur() int64 {
+	if x != nil {
+		return x.WaitDur
+	}
+	return 0
+}
+
+type TriggerSTKResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload *StkTransaction `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Phone            string  `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Amount           float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccountReference string  `protobuf:"bytes,3,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
+	TransactionDesc  string  `protobuf:"bytes,4,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
+	WaitDur          int64   `protobuf:"varint,5,opt,name=wait_dur,json=waitDur,proto3" json:"wait_dur,omitempty"`
 }
 
-func (x *CreateStkTransactionRequest) Reset() {
-	*x = CreateStkTransactionRequest{}
+func (x *TriggerSTKResponse) Reset() {
+	*x = TriggerSTKResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[7]
+		mi := &file_stk_v1_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateStkTransactionRequest) String() string {
+func (x *TriggerSTKResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStkTransactionRequest) ProtoMessage() {}
+func (*TriggerSTKResponse) ProtoMessage() {}
 
-func (x *CreateStkTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[7]
+func (x *TriggerSTKResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,53 +1370,85 @@ func (x *CreateStkTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStkTransactionRequest.ProtoReflect.Descriptor instead.
-func (*CreateStkTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use TriggerSTKResponse.ProtoReflect.Descriptor instead.
+func (*TriggerSTKResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateStkTransactionRequest) GetPayload() *StkTransaction {
+func (x *TriggerSTKResponse) GetPhone() string {
 	if x != nil {
-		return x.Payload
+		return x.Phone
 	}
-	return nil
+	return ""
 }
 
-type ListStkTransactionFilter struct {
+func (x *TriggerSTKResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TriggerSTKResponse) GetAccountReference() string {
+	if x != nil {
+		return x.AccountReference
+	}
+	return ""
+}
+
+func (x *TriggerSTKResponse) GetTransactionDesc() string {
+	if x != nil {
+		return x.TransactionDesc
+	}
+	return ""
+}
+
+func (x *TriggerSTKResponse) GetWaitDur() int64 {
+	if x != nil {
+		return x.WaitDur
+	}
+	return 0
+}
+
+type InitiateSTKRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxDate                         string            `protobuf:"bytes,1,opt,name=tx_date,json=txDate,proto3" json:"tx_date,omitempty"`
-	Msisdns                        []string          `protobuf:"bytes,2,rep,name=msisdns,proto3" json:"msisdns,omitempty"`
-	MpesaReceipts                  []string          `protobuf:"bytes,3,rep,name=mpesa_receipts,json=mpesaReceipts,proto3" json:"mpesa_receipts,omitempty"`
-	InitiatorCustomerReferences    []string          `protobuf:"bytes,4,rep,name=initiator_customer_references,json=initiatorCustomerReferences,proto3" json:"initiator_customer_references,omitempty"`
-	InitiatorTransactionReferences []string          `protobuf:"bytes,5,rep,name=initiator_transaction_references,json=initiatorTransactionReferences,proto3" json:"initiator_transaction_references,omitempty"`
-	ShortCodes                     []string          `protobuf:"bytes,6,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
-	StkStatuses                    []StkStatus       `protobuf:"varint,7,rep,packed,name=stk_statuses,json=stkStatuses,proto3,enum=gidyon.mpesastk.StkStatus" json:"stk_statuses,omitempty"`
-	ProcessState                   StkProcessedState `protobuf:"varint,8,opt,name=process_state,json=processState,proto3,enum=gidyon.mpesastk.StkProcessedState" json:"process_state,omitempty"`
-	StartTimestamp                 int64             `protobuf:"varint,9,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	EndTimestamp                   int64             `protobuf:"varint,10,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-	OrderField                     StkOrderField     `protobuf:"varint,11,opt,name=order_field,json=orderField,proto3,enum=gidyon.mpesastk.StkOrderField" json:"order_field,omitempty"`
+	InitiatorId                   string             `protobuf:"bytes,1,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	InitiatorTransactionReference string             `protobuf:"bytes,2,opt,name=initiator_transaction_reference,json=initiatorTransactionReference,proto3" json:"initiator_transaction_reference,omitempty"`
+	InitiatorCustomerReference    string             `protobuf:"bytes,3,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
+	InitiatorCustomerNames        string             `protobuf:"bytes,4,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
+	Phone                         string             `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Amount                        float64            `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	ShortCode                     string             `protobuf:"bytes,7,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	AccountReference              string             `protobuf:"bytes,8,opt,name=account_reference,json=accountReference,proto3" json:"account_reference,omitempty"`
+	TransactionDesc               string             `protobuf:"bytes,9,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
+	Publish                       bool               `protobuf:"varint,10,opt,name=publish,proto3" json:"publish,omitempty"`
+	PublishMessage                *PublishInfo       `protobuf:"bytes,11,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
+	TransactionType               StkTransactionType `protobuf:"varint,12,opt,name=transaction_type,json=transactionType,proto3,enum=gidyon.mpesastk.StkTransactionType" json:"transaction_type,omitempty"`
+	IdempotencyKey                string             `protobuf:"bytes,13,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Synchronous                   bool               `protobuf:"varint,14,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+	TimeoutSeconds                int32              `protobuf:"varint,15,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
 }
 
-func (x *ListStkTransactionFilter) Reset() {
-	*x = ListStkTransactionFilter{}
+func (x *InitiateSTKRequest) Reset() {
+	*x = InitiateSTKRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[8]
+		mi := &file_stk_v1_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListStkTransactionFilter) String() string {
+func (x *InitiateSTKRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStkTransactionFilter) ProtoMessage() {}
+func (*InitiateSTKRequest) ProtoMessage() {}
 
-func (x *ListStkTransactionFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[8]
+func (x *InitiateSTKRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,116 +1459,145 @@ func (x *ListStkTransactionFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStkTransactionFilter.ProtoReflect.Descriptor instead.
-func (*ListStkTransactionFilter) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use InitiateSTKRequest.ProtoReflect.Descriptor instead.
+func (*InitiateSTKRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListStkTransactionFilter) GetTxDate() string {
+func (x *InitiateSTKRequest) GetInitiatorId() string {
 	if x != nil {
-		return x.TxDate
+		return x.InitiatorId
 	}
 	return ""
 }
 
-func (x *ListStkTransactionFilter) GetMsisdns() []string {
+func (x *InitiateSTKRequest) GetInitiatorTransactionReference() string {
 	if x != nil {
-		return x.Msisdns
+		return x.InitiatorTransactionReference
 	}
-	return nil
+	return ""
 }
 
-func (x *ListStkTransactionFilter) GetMpesaReceipts() []string {
+func (x *InitiateSTKRequest) GetInitiatorCustomerReference() string {
 	if x != nil {
-		return x.MpesaReceipts
+		return x.InitiatorCustomerReference
 	}
-	return nil
+	return ""
 }
 
-func (x *ListStkTransactionFilter) GetInitiatorCustomerReferences() []string {
+func (x *InitiateSTKRequest) GetInitiatorCustomerNames() string {
 	if x != nil {
-		return x.InitiatorCustomerReferences
+		return x.InitiatorCustomerNames
 	}
-	return nil
+	return ""
 }
 
-func (x *ListStkTransactionFilter) GetInitiatorTransactionReferences() []string {
+func (x *InitiateSTKRequest) GetPhone() string {
 	if x != nil {
-		return x.InitiatorTransactionReferences
+		return x.Phone
 	}
-	return nil
+	return ""
 }
 
-func (x *ListStkTransactionFilter) GetShortCodes() []string {
+func (x *InitiateSTKRequest) GetAmount() float64 {
 	if x != nil {
-		return x.ShortCodes
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListStkTransactionFilter) GetStkStatuses() []StkStatus {
+func (x *InitiateSTKRequest) GetShortCode() string {
 	if x != nil {
-		return x.StkStatuses
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *InitiateSTKRequest) GetAccountReference() string {
+	if x != nil {
+		return x.AccountReference
+	}
+	return ""
+}
+
+func (x *InitiateSTKRequest) GetTransactionDesc() string {
+	if x != nil {
+		return x.TransactionDesc
+	}
+	return ""
+}
+
+func (x *InitiateSTKRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+func (x *InitiateSTKRequest) GetPublishMessage() *PublishInfo {
+	if x != nil {
+		return x.PublishMessage
 	}
 	return nil
 }
 
-func (x *ListStkTransactionFilter) GetProcessState() StkProcessedState {
+func (x *InitiateSTKRequest) GetTransactionType() StkTransactionType {
 	if x != nil {
-		return x.ProcessState
+		return x.TransactionType
 	}
-	return StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED
+	return StkTransactionType_STK_TRANSACTION_TYPE_UNSPECIFIED
 }
 
-func (x *ListStkTransactionFilter) GetStartTimestamp() int64 {
+func (x *InitiateSTKRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.StartTimestamp
+		return x.IdempotencyKey
 	}
-	return 0
+	return ""
 }
 
-func (x *ListStkTransactionFilter) GetEndTimestamp() int64 {
+func (x *InitiateSTKRequest) GetSynchronous() bool {
 	if x != nil {
-		return x.EndTimestamp
+		return x.Synchronous
 	}
-	return 0
+	return false
 }
 
-func (x *ListStkTransactionFilter) GetOrderField() StkOrderField {
+func (x *InitiateSTKRequest) GetTimeoutSeconds() int32 {
 	if x != nil {
-		return x.OrderField
+		return x.TimeoutSeconds
 	}
-	return StkOrderField_STK_ORDER_FIELD_UNSPECIFIED
+	return 0
 }
 
-type ListStkTransactionsRequest struct {
+type InitiateSTKResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageToken string                    `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32                     `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Filter    *ListStkTransactionFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	View      ListStkTransactionsView   `protobuf:"varint,4,opt,name=view,proto3,enum=gidyon.mpesastk.ListStkTransactionsView" json:"view,omitempty"`
+	Progress       bool            `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Message        string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Duplicate      bool            `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	StkTransaction *StkTransaction `protobuf:"bytes,4,opt,name=stk_transaction,json=stkTransaction,proto3" json:"stk_transaction,omitempty"`
+	TransactionId  uint64          `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *ListStkTransactionsRequest) Reset() {
-	*x = ListStkTransactionsRequest{}
+func (x *InitiateSTKResponse) Reset() {
+	*x = InitiateSTKResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[9]
+		mi := &file_stk_v1_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListStkTransactionsRequest) String() string {
+func (x *InitiateSTKResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStkTransactionsRequest) ProtoMessage() {}
+func (*InitiateSTKResponse) ProtoMessage() {}
 
-func (x *ListStkTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[9]
+func (x *InitiateSTKResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,66 +1608,72 @@ func (x *ListStkTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStkTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListStkTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use InitiateSTKResponse.ProtoReflect.Descriptor instead.
+func (*InitiateSTKResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListStkTransactionsRequest) GetPageToken() string {
+func (x *InitiateSTKResponse) GetProgress() bool {
 	if x != nil {
-		return x.PageToken
+		return x.Progress
+	}
+	return false
+}
+
+func (x *InitiateSTKResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ListStkTransactionsRequest) GetPageSize() int32 {
+func (x *InitiateSTKResponse) GetDuplicate() bool {
 	if x != nil {
-		return x.PageSize
+		return x.Duplicate
 	}
-	return 0
+	return false
 }
 
-func (x *ListStkTransactionsRequest) GetFilter() *ListStkTransactionFilter {
+func (x *InitiateSTKResponse) GetStkTransaction() *StkTransaction {
 	if x != nil {
-		return x.Filter
+		return x.StkTransaction
 	}
 	return nil
 }
 
-func (x *ListStkTransactionsRequest) GetView() ListStkTransactionsView {
+func (x *InitiateSTKResponse) GetTransactionId() uint64 {
 	if x != nil {
-		return x.View
+		return x.TransactionId
 	}
-	return ListStkTransactionsView_BASIC_VIEW
+	return 0
 }
 
-type ListStkTransactionsResponse struct {
+type GetStkTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextPageToken   string            `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	StkTransactions []*StkTransaction `protobuf:"bytes,2,rep,name=stk_transactions,json=stkTransactions,proto3" json:"stk_transactions,omitempty"`
-	CollectionCount int64             `protobuf:"varint,3,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
+	TransactionId  uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MpesaReceiptId string `protobuf:"bytes,2,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
 }
 
-func (x *ListStkTransactionsResponse) Reset() {
-	*x = ListStkTransactionsResponse{}
+func (x *GetStkTransactionRequest) Reset() {
+	*x = GetStkTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[10]
+		mi := &file_stk_v1_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListStkTransactionsResponse) String() string {
+func (x *GetStkTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStkTransactionsResponse) ProtoMessage() {}
+func (*GetStkTransactionRequest) ProtoMessage() {}
 
-func (x *ListStkTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[10]
+func (x *GetStkTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,59 +1684,112 @@ func (x *ListStkTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStkTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListStkTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetStkTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetStkTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListStkTransactionsResponse) GetNextPageToken() string {
+func (x *GetStkTransactionRequest) GetTransactionId() uint64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *GetStkTransactionRequest) GetMpesaReceiptId() string {
+	if x != nil {
+		return x.MpesaReceiptId
 	}
 	return ""
 }
 
-func (x *ListStkTransactionsResponse) GetStkTransactions() []*StkTransaction {
+type WaitStkResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId  uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+}
+
+func (x *WaitStkResultRequest) Reset() {
+	*x = WaitStkResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitStkResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitStkResultRequest) ProtoMessage() {}
+
+func (x *WaitStkResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitStkResultRequest.ProtoReflect.Descriptor instead.
+func (*WaitStkResultRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WaitStkResultRequest) GetTransactionId() uint64 {
 	if x != nil {
-		return x.StkTransactions
+		return x.TransactionId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListStkTransactionsResponse) GetCollectionCount() int64 {
+func (x *WaitStkResultRequest) GetTimeoutSeconds() int32 {
 	if x != nil {
-		return x.CollectionCount
+		return x.TimeoutSeconds
 	}
 	return 0
 }
 
-type ProcessStkTransactionRequest struct {
+type StkTransactionEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransactionId  uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	MpesaReceiptId string `protobuf:"bytes,2,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
-	Processed      bool   `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+	EventId         uint64         `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TransactionId   uint64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	FromStatus      StkStatus      `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=gidyon.mpesastk.StkStatus" json:"from_status,omitempty"`
+	ToStatus        StkStatus      `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=gidyon.mpesastk.StkStatus" json:"to_status,omitempty"`
+	Source          StkEventSource `protobuf:"varint,5,opt,name=source,proto3,enum=gidyon.mpesastk.StkEventSource" json:"source,omitempty"`
+	Description     string         `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ActorId         string         `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreateTimestamp int64          `protobuf:"varint,8,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
 }
 
-func (x *ProcessStkTransactionRequest) Reset() {
-	*x = ProcessStkTransactionRequest{}
+func (x *StkTransactionEvent) Reset() {
+	*x = StkTransactionEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[11]
+		mi := &file_stk_v1_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProcessStkTransactionRequest) String() string {
+func (x *StkTransactionEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessStkTransactionRequest) ProtoMessage() {}
+func (*StkTransactionEvent) ProtoMessage() {}
 
-func (x *ProcessStkTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[11]
+func (x *StkTransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,43 +1800,265 @@ func (x *ProcessStkTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessStkTransactionRequest.ProtoReflect.Descriptor instead.
-func (*ProcessStkTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StkTransactionEvent.ProtoReflect.Descriptor instead.
+func (*StkTransactionEvent) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ProcessStkTransactionRequest) GetTransactionId() uint64 {
+func (x *StkTransactionEvent) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *StkTransactionEvent) GetTransactionId() uint64 {
 	if x != nil {
 		return x.TransactionId
 	}
 	return 0
 }
 
-func (x *ProcessStkTransactionRequest) GetMpesaReceiptId() string {
+func (x *StkTransactionEvent) GetFromStatus() StkStatus {
 	if x != nil {
-		return x.MpesaReceiptId
+		return x.FromStatus
+	}
+	return StkStatus_STK_STATUS_UNKNOWN
+}
+
+func (x *StkTransactionEvent) GetToStatus() StkStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return StkStatus_STK_STATUS_UNKNOWN
+}
+
+func (x *StkTransactionEvent) GetSource() StkEventSource {
+	if x != nil {
+		return x.Source
+	}
+	return StkEventSource_STK_SOURCE_UNSPECIFIED
+}
+
+func (x *StkTransactionEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ProcessStkTransactionRequest) GetProcessed() bool {
+func (x *StkTransactionEvent) GetActorId() string {
 	if x != nil {
-		return x.Processed
+		return x.ActorId
 	}
-	return false
+	return ""
 }
 
-type PublishStkTransactionRequest struct {
+func (x *StkTransactionEvent) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+type ListStkTransactionEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublishMessage *PublishMessage   `protobuf:"bytes,1,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
-	ProcessedState StkProcessedState `protobuf:"varint,2,opt,name=processed_state,json=processedState,proto3,enum=gidyon.mpesastk.StkProcessedState" json:"processed_state,omitempty"`
+	TransactionId uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *PublishStkTransactionRequest) Reset() {
-	*x = PublishStkTransactionRequest{}
+func (x *ListStkTransactionEventsRequest) Reset() {
+	*x = ListStkTransactionEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkTransactionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkTransactionEventsRequest) ProtoMessage() {}
+
+func (x *ListStkTransactionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkTransactionEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListStkTransactionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListStkTransactionEventsRequest) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *ListStkTransactionEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStkTransactionEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListStkTransactionEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Events        []*StkTransactionEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListStkTransactionEventsResponse) Reset() {
+	*x = ListStkTransactionEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkTransactionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkTransactionEventsResponse) ProtoMessage() {}
+
+func (x *ListStkTransactionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkTransactionEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListStkTransactionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListStkTransactionEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStkTransactionEventsResponse) GetEvents() []*StkTransactionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateStkTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload *StkTransaction `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *CreateStkTransactionRequest) Reset() {
+	*x = CreateStkTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStkTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStkTransactionRequest) ProtoMessage() {}
+
+func (x *CreateStkTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStkTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateStkTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateStkTransactionRequest) GetPayload() *StkTransaction {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ListStkTransactionFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxDate                         string               `protobuf:"bytes,1,opt,name=tx_date,json=txDate,proto3" json:"tx_date,omitempty"`
+	Msisdns                        []string             `protobuf:"bytes,2,rep,name=msisdns,proto3" json:"msisdns,omitempty"`
+	MpesaReceipts                  []string             `protobuf:"bytes,3,rep,name=mpesa_receipts,json=mpesaReceipts,proto3" json:"mpesa_receipts,omitempty"`
+	InitiatorCustomerReferences    []string             `protobuf:"bytes,4,rep,name=initiator_customer_references,json=initiatorCustomerReferences,proto3" json:"initiator_customer_references,omitempty"`
+	InitiatorTransactionReferences []string             `protobuf:"bytes,5,rep,name=initiator_transaction_references,json=initiatorTransactionReferences,proto3" json:"initiator_transaction_references,omitempty"`
+	ShortCodes                     []string             `protobuf:"bytes,6,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
+	StkStatuses                    []StkStatus          `protobuf:"varint,7,rep,packed,name=stk_statuses,json=stkStatuses,proto3,enum=gidyon.mpesastk.StkStatus" json:"stk_statuses,omitempty"`
+	ProcessState                   StkProcessedState    `protobuf:"varint,8,opt,name=process_state,json=processState,proto3,enum=gidyon.mpesastk.StkProcessedState" json:"process_state,omitempty"`
+	StartTimestamp                 int64                `protobuf:"varint,9,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp                   int64                `protobuf:"varint,10,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	OrderField                     StkOrderField        `protobuf:"varint,11,opt,name=order_field,json=orderField,proto3,enum=gidyon.mpesastk.StkOrderField" json:"order_field,omitempty"`
+	TransactionTypes               []StkTransactionType `protobuf:"varint,12,rep,packed,name=transaction_types,json=transactionTypes,proto3,enum=gidyon.mpesastk.StkTransactionType" json:"transaction_types,omitempty"`
+	SortOrder                      StkSortOrder         `protobuf:"varint,13,opt,name=sort_order,json=sortOrder,proto3,enum=gidyon.mpesastk.StkSortOrder" json:"sort_order,omitempty"`
+	InitiatorIds                   []string             `protobuf:"bytes,14,rep,name=initiator_ids,json=initiatorIds,proto3" json:"initiator_ids,omitempty"`
+	AccountReferences              []string             `protobuf:"bytes,15,rep,name=account_references,json=accountReferences,proto3" json:"account_references,omitempty"`
+	SucceededState                 StkSucceededState    `protobuf:"varint,16,opt,name=succeeded_state,json=succeededState,proto3,enum=gidyon.mpesastk.StkSucceededState" json:"succeeded_state,omitempty"`
+	// Amount range, inclusive; zero means no bound
+	MinAmount float64 `protobuf:"fixed64,17,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount float64 `protobuf:"fixed64,18,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// Matches words in customer names or transaction description starting with the given words
+	CustomerNames   string `protobuf:"bytes,19,opt,name=customer_names,json=customerNames,proto3" json:"customer_names,omitempty"`
+	TransactionDesc string `protobuf:"bytes,20,opt,name=transaction_desc,json=transactionDesc,proto3" json:"transaction_desc,omitempty"`
+	// Prefix search over mpesa receipt, phone number and account reference, and word search over customer names
+	Query string `protobuf:"bytes,21,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *ListStkTransactionFilter) Reset() {
+	*x = ListStkTransactionFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_stk_v1_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,646 +2066,7279 @@ func (x *PublishStkTransactionRequest) Reset() {
 	}
 }
 
-func (x *PublishStkTransactionRequest) String() string {
+func (x *ListStkTransactionFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishStkTransactionRequest) ProtoMessage() {}
+func (*ListStkTransactionFilter) ProtoMessage() {}
 
-func (x *PublishStkTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *ListStkTransactionFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_stk_v1_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PublishStkTransactionRequest.ProtoReflect.Descriptor instead.
-func (*PublishStkTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *PublishStkTransactionRequest) GetPublishMessage() *PublishMessage {
-	if x != nil {
-		return x.PublishMessage
-	}
-	return nil
-}
-
-func (x *PublishStkTransactionRequest) GetProcessedState() StkProcessedState {
-	if x != nil {
-		return x.ProcessedState
-	}
-	return StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED
-}
-
-type PublishMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TransactionId   uint64          `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	InitiatorId     string          `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	MpesaReceiptId  string          `protobuf:"bytes,3,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
-	PhoneNumber     string          `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	PublishInfo     *PublishInfo    `protobuf:"bytes,5,opt,name=publish_info,json=publishInfo,proto3" json:"publish_info,omitempty"`
-	TransactionInfo *StkTransaction `protobuf:"bytes,6,opt,name=transaction_info,json=transactionInfo,proto3" json:"transaction_info,omitempty"`
-}
-
-func (x *PublishMessage) Reset() {
-	*x = PublishMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stk_v1_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *PublishMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PublishMessage) ProtoMessage() {}
-
-func (x *PublishMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_stk_v1_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkTransactionFilter.ProtoReflect.Descriptor instead.
+func (*ListStkTransactionFilter) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListStkTransactionFilter) GetTxDate() string {
+	if x != nil {
+		return x.TxDate
+	}
+	return ""
+}
+
+func (x *ListStkTransactionFilter) GetMsisdns() []string {
+	if x != nil {
+		return x.Msisdns
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetMpesaReceipts() []string {
+	if x != nil {
+		return x.MpesaReceipts
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetInitiatorCustomerReferences() []string {
+	if x != nil {
+		return x.InitiatorCustomerReferences
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetInitiatorTransactionReferences() []string {
+	if x != nil {
+		return x.InitiatorTransactionReferences
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetShortCodes() []string {
+	if x != nil {
+		return x.ShortCodes
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetStkStatuses() []StkStatus {
+	if x != nil {
+		return x.StkStatuses
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetProcessState() StkProcessedState {
+	if x != nil {
+		return x.ProcessState
+	}
+	return StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED
+}
+
+func (x *ListStkTransactionFilter) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *ListStkTransactionFilter) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *ListStkTransactionFilter) GetOrderField() StkOrderField {
+	if x != nil {
+		return x.OrderField
+	}
+	return StkOrderField_STK_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *ListStkTransactionFilter) GetTransactionTypes() []StkTransactionType {
+	if x != nil {
+		return x.TransactionTypes
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetSortOrder() StkSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return StkSortOrder_STK_SORT_DESCENDING
+}
+
+func (x *ListStkTransactionFilter) GetInitiatorIds() []string {
+	if x != nil {
+		return x.InitiatorIds
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetAccountReferences() []string {
+	if x != nil {
+		return x.AccountReferences
+	}
+	return nil
+}
+
+func (x *ListStkTransactionFilter) GetSucceededState() StkSucceededState {
+	if x != nil {
+		return x.SucceededState
+	}
+	return StkSucceededState_STK_SUCCEEDED_STATE_UNSPECIFIED
+}
+
+func (x *ListStkTransactionFilter) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListStkTransactionFilter) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListStkTransactionFilter) GetCustomerNames() string {
+	if x != nil {
+		return x.CustomerNames
+	}
+	return ""
+}
+
+func (x *ListStkTransactionFilter) GetTransactionDesc() string {
+	if x != nil {
+		return x.TransactionDesc
+	}
+	return ""
+}
+
+func (x *ListStkTransactionFilter) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListStkTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string                    `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                     `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListStkTransactionFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	View      ListStkTransactionsView   `protobuf:"varint,4,opt,name=view,proto3,enum=gidyon.mpesastk.ListStkTransactionsView" json:"view,omitempty"`
+}
+
+func (x *ListStkTransactionsRequest) Reset() {
+	*x = ListStkTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkTransactionsRequest) ProtoMessage() {}
+
+func (x *ListStkTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListStkTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListStkTransactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStkTransactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStkTransactionsRequest) GetFilter() *ListStkTransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListStkTransactionsRequest) GetView() ListStkTransactionsView {
+	if x != nil {
+		return x.View
+	}
+	return ListStkTransactionsView_BASIC_VIEW
+}
+
+type ListStkTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken   string            `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	StkTransactions []*StkTransaction `protobuf:"bytes,2,rep,name=stk_transactions,json=stkTransactions,proto3" json:"stk_transactions,omitempty"`
+	CollectionCount int64             `protobuf:"varint,3,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
+}
+
+func (x *ListStkTransactionsResponse) Reset() {
+	*x = ListStkTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkTransactionsResponse) ProtoMessage() {}
+
+func (x *ListStkTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListStkTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListStkTransactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStkTransactionsResponse) GetStkTransactions() []*StkTransaction {
+	if x != nil {
+		return x.StkTransactions
+	}
+	return nil
+}
+
+func (x *ListStkTransactionsResponse) GetCollectionCount() int64 {
+	if x != nil {
+		return x.CollectionCount
+	}
+	return 0
+}
+
+type WatchStkTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListStkTransactionFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *WatchStkTransactionsRequest) Reset() {
+	*x = WatchStkTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStkTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStkTransactionsRequest) ProtoMessage() {}
+
+func (x *WatchStkTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStkTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStkTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchStkTransactionsRequest) GetFilter() *ListStkTransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type WatchStkTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType      StkTransactionEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=gidyon.mpesastk.StkTransactionEventType" json:"event_type,omitempty"`
+	StkTransaction *StkTransaction         `protobuf:"bytes,2,opt,name=stk_transaction,json=stkTransaction,proto3" json:"stk_transaction,omitempty"`
+	EventTimestamp int64                   `protobuf:"varint,3,opt,name=event_timestamp,json=eventTimestamp,proto3" json:"event_timestamp,omitempty"`
+}
+
+func (x *WatchStkTransactionsResponse) Reset() {
+	*x = WatchStkTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStkTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStkTransactionsResponse) ProtoMessage() {}
+
+func (x *WatchStkTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStkTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*WatchStkTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchStkTransactionsResponse) GetEventType() StkTransactionEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return StkTransactionEventType_STK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchStkTransactionsResponse) GetStkTransaction() *StkTransaction {
+	if x != nil {
+		return x.StkTransaction
+	}
+	return nil
+}
+
+func (x *WatchStkTransactionsResponse) GetEventTimestamp() int64 {
+	if x != nil {
+		return x.EventTimestamp
+	}
+	return 0
+}
+
+type ProcessStkTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId  uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MpesaReceiptId string `protobuf:"bytes,2,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	Processed      bool   `protobuf:"varint,3,opt,name=processed,proto3" json:"processed,omitempty"`
+}
+
+func (x *ProcessStkTransactionRequest) Reset() {
+	*x = ProcessStkTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessStkTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStkTransactionRequest) ProtoMessage() {}
+
+func (x *ProcessStkTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStkTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ProcessStkTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProcessStkTransactionRequest) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *ProcessStkTransactionRequest) GetMpesaReceiptId() string {
+	if x != nil {
+		return x.MpesaReceiptId
+	}
+	return ""
+}
+
+func (x *ProcessStkTransactionRequest) GetProcessed() bool {
+	if x != nil {
+		return x.Processed
+	}
+	return false
+}
+
+type PublishStkTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublishMessage *PublishMessage   `protobuf:"bytes,1,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
+	ProcessedState StkProcessedState `protobuf:"varint,2,opt,name=processed_state,json=processedState,proto3,enum=gidyon.mpesastk.StkProcessedState" json:"processed_state,omitempty"`
+}
+
+func (x *PublishStkTransactionRequest) Reset() {
+	*x = PublishStkTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishStkTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishStkTransactionRequest) ProtoMessage() {}
+
+func (x *PublishStkTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishStkTransactionRequest.ProtoReflect.Descriptor instead.
+func (*PublishStkTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PublishStkTransactionRequest) GetPublishMessage() *PublishMessage {
+	if x != nil {
+		return x.PublishMessage
+	}
+	return nil
+}
+
+func (x *PublishStkTransactionRequest) GetProcessedState() StkProcessedState {
+	if x != nil {
+		return x.ProcessedState
+	}
+	return StkProcessedState_STK_PROCESS_STATE_UNSPECIFIED
+}
+
+type PublishMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId   uint64          `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	InitiatorId     string          `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	MpesaReceiptId  string          `protobuf:"bytes,3,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	PhoneNumber     string          `protobuf:"bytes,4,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	PublishInfo     *PublishInfo    `protobuf:"bytes,5,opt,name=publish_info,json=publishInfo,proto3" json:"publish_info,omitempty"`
+	TransactionInfo *StkTransaction `protobuf:"bytes,6,opt,name=transaction_info,json=transactionInfo,proto3" json:"transaction_info,omitempty"`
+}
+
+func (x *PublishMessage) Reset() {
+	*x = PublishMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishMessage) ProtoMessage() {}
+
+func (x *PublishMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishMessage.ProtoReflect.Descriptor instead.
+func (*PublishMessage) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PublishMessage) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *PublishMessage) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *PublishMessage) GetMpesaReceiptId() string {
+	if x != nil {
+		return x.MpesaReceiptId
+	}
+	return ""
+}
+
+func (x *PublishMessage) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *PublishMessage) GetPublishInfo() *PublishInfo {
+	if x != nil {
+		return x.PublishInfo
+	}
+	return nil
+}
+
+func (x *PublishMessage) GetTransactionInfo() *StkTransaction {
+	if x != nil {
+		return x.TransactionInfo
+	}
+	return nil
+}
+
+// CloudEvent is a CloudEvents 1.0 event in protobuf format. Field numbers match io.cloudevents.v1.CloudEvent
+// hence consumers can decode it with the official schema.
+type CloudEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source      string                               `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	SpecVersion string                               `protobuf:"bytes,3,opt,name=spec_version,json=specVersion,proto3" json:"spec_version,omitempty"`
+	Type        string                               `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes  map[string]*CloudEventAttributeValue `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Data:
+	//	*CloudEvent_BinaryData
+	//	*CloudEvent_TextData
+	//	*CloudEvent_ProtoData
+	Data isCloudEvent_Data `protobuf_oneof:"data"`
+}
+
+func (x *CloudEvent) Reset() {
+	*x = CloudEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudEvent) ProtoMessage() {}
+
+func (x *CloudEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudEvent.ProtoReflect.Descriptor instead.
+func (*CloudEvent) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CloudEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetSpecVersion() string {
+	if x != nil {
+		return x.SpecVersion
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetAttributes() map[string]*CloudEventAttributeValue {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (m *CloudEvent) GetData() isCloudEvent_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CloudEvent) GetBinaryData() []byte {
+	if x, ok := x.GetData().(*CloudEvent_BinaryData); ok {
+		return x.BinaryData
+	}
+	return nil
+}
+
+func (x *CloudEvent) GetTextData() string {
+	if x, ok := x.GetData().(*CloudEvent_TextData); ok {
+		return x.TextData
+	}
+	return ""
+}
+
+func (x *CloudEvent) GetProtoData() *any1.Any {
+	if x, ok := x.GetData().(*CloudEvent_ProtoData); ok {
+		return x.ProtoData
+	}
+	return nil
+}
+
+type isCloudEvent_Data interface {
+	isCloudEvent_Data()
+}
+
+type CloudEvent_BinaryData struct {
+	BinaryData []byte `protobuf:"bytes,6,opt,name=binary_data,json=binaryData,proto3,oneof"`
+}
+
+type CloudEvent_TextData struct {
+	TextData string `protobuf:"bytes,7,opt,name=text_data,json=textData,proto3,oneof"`
+}
+
+type CloudEvent_ProtoData struct {
+	ProtoData *any1.Any `protobuf:"bytes,8,opt,name=proto_data,json=protoData,proto3,oneof"`
+}
+
+func (*CloudEvent_BinaryData) isCloudEvent_Data() {}
+
+func (*CloudEvent_TextData) isCloudEvent_Data() {}
+
+func (*CloudEvent_ProtoData) isCloudEvent_Data() {}
+
+type CloudEventAttributeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Attr:
+	//	*CloudEventAttributeValue_CeBoolean
+	//	*CloudEventAttributeValue_CeInteger
+	//	*CloudEventAttributeValue_CeString
+	//	*CloudEventAttributeValue_CeBytes
+	//	*CloudEventAttributeValue_CeUri
+	//	*CloudEventAttributeValue_CeUriRef
+	//	*CloudEventAttributeValue_CeTimestamp
+	Attr isCloudEventAttributeValue_Attr `protobuf_oneof:"attr"`
+}
+
+func (x *CloudEventAttributeValue) Reset() {
+	*x = CloudEventAttributeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudEventAttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudEventAttributeValue) ProtoMessage() {}
+
+func (x *CloudEventAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudEventAttributeValue.ProtoReflect.Descriptor instead.
+func (*CloudEventAttributeValue) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *CloudEventAttributeValue) GetAttr() isCloudEventAttributeValue_Attr {
+	if m != nil {
+		return m.Attr
+	}
+	return nil
+}
+
+func (x *CloudEventAttributeValue) GetCeBoolean() bool {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeBoolean); ok {
+		return x.CeBoolean
+	}
+	return false
+}
+
+func (x *CloudEventAttributeValue) GetCeInteger() int32 {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeInteger); ok {
+		return x.CeInteger
+	}
+	return 0
+}
+
+func (x *CloudEventAttributeValue) GetCeString() string {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeString); ok {
+		return x.CeString
+	}
+	return ""
+}
+
+func (x *CloudEventAttributeValue) GetCeBytes() []byte {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeBytes); ok {
+		return x.CeBytes
+	}
+	return nil
+}
+
+func (x *CloudEventAttributeValue) GetCeUri() string {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeUri); ok {
+		return x.CeUri
+	}
+	return ""
+}
+
+func (x *CloudEventAttributeValue) GetCeUriRef() string {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeUriRef); ok {
+		return x.CeUriRef
+	}
+	return ""
+}
+
+func (x *CloudEventAttributeValue) GetCeTimestamp() *timestamp.Timestamp {
+	if x, ok := x.GetAttr().(*CloudEventAttributeValue_CeTimestamp); ok {
+		return x.CeTimestamp
+	}
+	return nil
+}
+
+type isCloudEventAttributeValue_Attr interface {
+	isCloudEventAttributeValue_Attr()
+}
+
+type CloudEventAttributeValue_CeBoolean struct {
+	CeBoolean bool `protobuf:"varint,1,opt,name=ce_boolean,json=ceBoolean,proto3,oneof"`
+}
+
+type CloudEventAttributeValue_CeInteger struct {
+	CeInteger int32 `protobuf:"varint,2,opt,name=ce_integer,json=ceInteger,proto3,oneof"`
+}
+
+type CloudEventAttributeValue_CeString struct {
+	CeString string `protobuf:"bytes,3,opt,name=ce_string,json=ceString,proto3,oneof"`
+}
+
+type CloudEventAttributeValue_CeBytes struct {
+	CeBytes []byte `protobuf:"bytes,4,opt,name=ce_bytes,json=ceBytes,proto3,oneof"`
+}
+
+type CloudEventAttributeValue_CeUri struct {
+	CeUri string `protobuf:"bytes,5,opt,name=ce_uri,json=ceUri,proto3,oneof"`
+}
+
+type CloudEventAttributeValue_CeUriRef struct {
+	CeUriRef string `protobuf:"bytes,6,opt,name=ce_uri_ref,json=ceUriRef,proto3,oneof"`
+}
+
+type CloudEventAttributeValue_CeTimestamp struct {
+	CeTimestamp *timestamp.Timestamp `protobuf:"bytes,7,opt,name=ce_timestamp,json=ceTimestamp,proto3,oneof"`
+}
+
+func (*CloudEventAttributeValue_CeBoolean) isCloudEventAttributeValue_Attr() {}
+
+func (*CloudEventAttributeValue_CeInteger) isCloudEventAttributeValue_Attr() {}
+
+func (*CloudEventAttributeValue_CeString) isCloudEventAttributeValue_Attr() {}
+
+func (*CloudEventAttributeValue_CeBytes) isCloudEventAttributeValue_Attr() {}
+
+func (*CloudEventAttributeValue_CeUri) isCloudEventAttributeValue_Attr() {}
+
+func (*CloudEventAttributeValue_CeUriRef) isCloudEventAttributeValue_Attr() {}
+
+func (*CloudEventAttributeValue_CeTimestamp) isCloudEventAttributeValue_Attr() {}
+
+type StkCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallbackId        uint64            `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	CheckoutRequestId string            `protobuf:"bytes,2,opt,name=checkout_request_id,json=checkoutRequestId,proto3" json:"checkout_request_id,omitempty"`
+	MerchantRequestId string            `protobuf:"bytes,3,opt,name=merchant_request_id,json=merchantRequestId,proto3" json:"merchant_request_id,omitempty"`
+	ResultCode        string            `protobuf:"bytes,4,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	Body              string            `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Headers           map[string]string `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RemoteAddr        string            `protobuf:"bytes,7,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	State             StkCallbackState  `protobuf:"varint,8,opt,name=state,proto3,enum=gidyon.mpesastk.StkCallbackState" json:"state,omitempty"`
+	Error             string            `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	TransactionId     uint64            `protobuf:"varint,10,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreateTimestamp   int64             `protobuf:"varint,11,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+}
+
+func (x *StkCallback) Reset() {
+	*x = StkCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkCallback) ProtoMessage() {}
+
+func (x *StkCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkCallback.ProtoReflect.Descriptor instead.
+func (*StkCallback) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StkCallback) GetCallbackId() uint64 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
+}
+
+func (x *StkCallback) GetCheckoutRequestId() string {
+	if x != nil {
+		return x.CheckoutRequestId
+	}
+	return ""
+}
+
+func (x *StkCallback) GetMerchantRequestId() string {
+	if x != nil {
+		return x.MerchantRequestId
+	}
+	return ""
+}
+
+func (x *StkCallback) GetResultCode() string {
+	if x != nil {
+		return x.ResultCode
+	}
+	return ""
+}
+
+func (x *StkCallback) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *StkCallback) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *StkCallback) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *StkCallback) GetState() StkCallbackState {
+	if x != nil {
+		return x.State
+	}
+	return StkCallbackState_STK_CALLBACK_STATE_UNSPECIFIED
+}
+
+func (x *StkCallback) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StkCallback) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *StkCallback) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+type GetStkCallbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallbackId uint64 `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+}
+
+func (x *GetStkCallbackRequest) Reset() {
+	*x = GetStkCallbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStkCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStkCallbackRequest) ProtoMessage() {}
+
+func (x *GetStkCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStkCallbackRequest.ProtoReflect.Descriptor instead.
+func (*GetStkCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetStkCallbackRequest) GetCallbackId() uint64 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
+}
+
+type ListStkCallbacksFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CheckoutRequestIds []string           `protobuf:"bytes,1,rep,name=checkout_request_ids,json=checkoutRequestIds,proto3" json:"checkout_request_ids,omitempty"`
+	States             []StkCallbackState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=gidyon.mpesastk.StkCallbackState" json:"states,omitempty"`
+	StartTimestamp     int64              `protobuf:"varint,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp       int64              `protobuf:"varint,4,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+}
+
+func (x *ListStkCallbacksFilter) Reset() {
+	*x = ListStkCallbacksFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkCallbacksFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkCallbacksFilter) ProtoMessage() {}
+
+func (x *ListStkCallbacksFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkCallbacksFilter.ProtoReflect.Descriptor instead.
+func (*ListStkCallbacksFilter) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListStkCallbacksFilter) GetCheckoutRequestIds() []string {
+	if x != nil {
+		return x.CheckoutRequestIds
+	}
+	return nil
+}
+
+func (x *ListStkCallbacksFilter) GetStates() []StkCallbackState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListStkCallbacksFilter) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *ListStkCallbacksFilter) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+type ListStkCallbacksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string                  `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListStkCallbacksFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListStkCallbacksRequest) Reset() {
+	*x = ListStkCallbacksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkCallbacksRequest) ProtoMessage() {}
+
+func (x *ListStkCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*ListStkCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListStkCallbacksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStkCallbacksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStkCallbacksRequest) GetFilter() *ListStkCallbacksFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListStkCallbacksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string         `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Callbacks     []*StkCallback `protobuf:"bytes,2,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+}
+
+func (x *ListStkCallbacksResponse) Reset() {
+	*x = ListStkCallbacksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStkCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStkCallbacksResponse) ProtoMessage() {}
+
+func (x *ListStkCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStkCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ListStkCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListStkCallbacksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStkCallbacksResponse) GetCallbacks() []*StkCallback {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+type ReplayCallbacksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListStkCallbacksFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	DryRun bool                    `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Limit  int32                   `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ReplayCallbacksRequest) Reset() {
+	*x = ReplayCallbacksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCallbacksRequest) ProtoMessage() {}
+
+func (x *ReplayCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*ReplayCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReplayCallbacksRequest) GetFilter() *ListStkCallbacksFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ReplayCallbacksRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReplayCallbacksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type StkFieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field    string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (x *StkFieldChange) Reset() {
+	*x = StkFieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkFieldChange) ProtoMessage() {}
+
+func (x *StkFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkFieldChange.ProtoReflect.Descriptor instead.
+func (*StkFieldChange) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StkFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *StkFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *StkFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type StkCallbackReplay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CallbackId         uint64            `protobuf:"varint,1,opt,name=callback_id,json=callbackId,proto3" json:"callback_id,omitempty"`
+	CheckoutRequestId  string            `protobuf:"bytes,2,opt,name=checkout_request_id,json=checkoutRequestId,proto3" json:"checkout_request_id,omitempty"`
+	TransactionId      uint64            `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatesTransaction bool              `protobuf:"varint,4,opt,name=creates_transaction,json=createsTransaction,proto3" json:"creates_transaction,omitempty"`
+	FromStatus         StkStatus         `protobuf:"varint,5,opt,name=from_status,json=fromStatus,proto3,enum=gidyon.mpesastk.StkStatus" json:"from_status,omitempty"`
+	ToStatus           StkStatus         `protobuf:"varint,6,opt,name=to_status,json=toStatus,proto3,enum=gidyon.mpesastk.StkStatus" json:"to_status,omitempty"`
+	Changes            []*StkFieldChange `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	Applied            bool              `protobuf:"varint,8,opt,name=applied,proto3" json:"applied,omitempty"`
+	Error              string            `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StkCallbackReplay) Reset() {
+	*x = StkCallbackReplay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkCallbackReplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkCallbackReplay) ProtoMessage() {}
+
+func (x *StkCallbackReplay) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkCallbackReplay.ProtoReflect.Descriptor instead.
+func (*StkCallbackReplay) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StkCallbackReplay) GetCallbackId() uint64 {
+	if x != nil {
+		return x.CallbackId
+	}
+	return 0
+}
+
+func (x *StkCallbackReplay) GetCheckoutRequestId() string {
+	if x != nil {
+		return x.CheckoutRequestId
+	}
+	return ""
+}
+
+func (x *StkCallbackReplay) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *StkCallbackReplay) GetCreatesTransaction() bool {
+	if x != nil {
+		return x.CreatesTransaction
+	}
+	return false
+}
+
+func (x *StkCallbackReplay) GetFromStatus() StkStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return StkStatus_STK_STATUS_UNKNOWN
+}
+
+func (x *StkCallbackReplay) GetToStatus() StkStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return StkStatus_STK_STATUS_UNKNOWN
+}
+
+func (x *StkCallbackReplay) GetChanges() []*StkFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *StkCallbackReplay) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *StkCallbackReplay) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReplayCallbacksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun       bool                 `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	AppliedCount int32                `protobuf:"varint,2,opt,name=applied_count,json=appliedCount,proto3" json:"applied_count,omitempty"`
+	FailedCount  int32                `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Replays      []*StkCallbackReplay `protobuf:"bytes,4,rep,name=replays,proto3" json:"replays,omitempty"`
+}
+
+func (x *ReplayCallbacksResponse) Reset() {
+	*x = ReplayCallbacksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCallbacksResponse) ProtoMessage() {}
+
+func (x *ReplayCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ReplayCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReplayCallbacksResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ReplayCallbacksResponse) GetAppliedCount() int32 {
+	if x != nil {
+		return x.AppliedCount
+	}
+	return 0
+}
+
+func (x *ReplayCallbacksResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ReplayCallbacksResponse) GetReplays() []*StkCallbackReplay {
+	if x != nil {
+		return x.Replays
+	}
+	return nil
+}
+
+type SetInitiatorWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitiatorId string `protobuf:"bytes,1,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	WebhookUrl  string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Secret      string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *SetInitiatorWebhookRequest) Reset() {
+	*x = SetInitiatorWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInitiatorWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInitiatorWebhookRequest) ProtoMessage() {}
+
+func (x *SetInitiatorWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInitiatorWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SetInitiatorWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetInitiatorWebhookRequest) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *SetInitiatorWebhookRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *SetInitiatorWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type InitiatorWebhook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitiatorId     string `protobuf:"bytes,1,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	WebhookUrl      string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Secret          string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	UpdateTimestamp int64  `protobuf:"varint,4,opt,name=update_timestamp,json=updateTimestamp,proto3" json:"update_timestamp,omitempty"`
+}
+
+func (x *InitiatorWebhook) Reset() {
+	*x = InitiatorWebhook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitiatorWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiatorWebhook) ProtoMessage() {}
+
+func (x *InitiatorWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiatorWebhook.ProtoReflect.Descriptor instead.
+func (*InitiatorWebhook) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InitiatorWebhook) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *InitiatorWebhook) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *InitiatorWebhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *InitiatorWebhook) GetUpdateTimestamp() int64 {
+	if x != nil {
+		return x.UpdateTimestamp
+	}
+	return 0
+}
+
+type WebhookAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttemptId       uint64 `protobuf:"varint,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	StatusCode      int32  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Error           string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs      int64  `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	CreateTimestamp int64  `protobuf:"varint,5,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+}
+
+func (x *WebhookAttempt) Reset() {
+	*x = WebhookAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookAttempt) ProtoMessage() {}
+
+func (x *WebhookAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookAttempt.ProtoReflect.Descriptor instead.
+func (*WebhookAttempt) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WebhookAttempt) GetAttemptId() uint64 {
+	if x != nil {
+		return x.AttemptId
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookAttempt) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *WebhookAttempt) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId           uint64               `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	TransactionId        uint64               `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	InitiatorId          string               `protobuf:"bytes,3,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	WebhookUrl           string               `protobuf:"bytes,4,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Payload              string               `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	State                WebhookDeliveryState `protobuf:"varint,6,opt,name=state,proto3,enum=gidyon.mpesastk.WebhookDeliveryState" json:"state,omitempty"`
+	Attempts             int32                `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptTimestamp int64                `protobuf:"varint,8,opt,name=next_attempt_timestamp,json=nextAttemptTimestamp,proto3" json:"next_attempt_timestamp,omitempty"`
+	LastStatusCode       int32                `protobuf:"varint,9,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError            string               `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	AttemptHistory       []*WebhookAttempt    `protobuf:"bytes,11,rep,name=attempt_history,json=attemptHistory,proto3" json:"attempt_history,omitempty"`
+	CreateTimestamp      int64                `protobuf:"varint,12,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+	UpdateTimestamp      int64                `protobuf:"varint,13,opt,name=update_timestamp,json=updateTimestamp,proto3" json:"update_timestamp,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() uint64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetState() WebhookDeliveryState {
+	if x != nil {
+		return x.State
+	}
+	return WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptTimestamp() int64 {
+	if x != nil {
+		return x.NextAttemptTimestamp
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttemptHistory() []*WebhookAttempt {
+	if x != nil {
+		return x.AttemptHistory
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetUpdateTimestamp() int64 {
+	if x != nil {
+		return x.UpdateTimestamp
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitiatorIds   []string               `protobuf:"bytes,1,rep,name=initiator_ids,json=initiatorIds,proto3" json:"initiator_ids,omitempty"`
+	TransactionIds []uint64               `protobuf:"varint,2,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	States         []WebhookDeliveryState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=gidyon.mpesastk.WebhookDeliveryState" json:"states,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesFilter) Reset() {
+	*x = ListWebhookDeliveriesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesFilter) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesFilter.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesFilter) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListWebhookDeliveriesFilter) GetInitiatorIds() []string {
+	if x != nil {
+		return x.InitiatorIds
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesFilter) GetTransactionIds() []uint64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesFilter) GetStates() []WebhookDeliveryState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string                       `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                        `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListWebhookDeliveriesFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetFilter() *ListWebhookDeliveriesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string             `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RetryWebhookDeliveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId uint64 `protobuf:"varint,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (x *RetryWebhookDeliveryRequest) Reset() {
+	*x = RetryWebhookDeliveryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RetryWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RetryWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RetryWebhookDeliveryRequest) GetDeliveryId() uint64 {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return 0
+}
+
+type OutboxEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryId              uint64           `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	TransactionId        uint64           `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Channel              string           `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	State                OutboxEntryState `protobuf:"varint,4,opt,name=state,proto3,enum=gidyon.mpesastk.OutboxEntryState" json:"state,omitempty"`
+	Attempts             int32            `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptTimestamp int64            `protobuf:"varint,6,opt,name=next_attempt_timestamp,json=nextAttemptTimestamp,proto3" json:"next_attempt_timestamp,omitempty"`
+	LastError            string           `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Stuck                bool             `protobuf:"varint,8,opt,name=stuck,proto3" json:"stuck,omitempty"`
+	DispatchTimestamp    int64            `protobuf:"varint,9,opt,name=dispatch_timestamp,json=dispatchTimestamp,proto3" json:"dispatch_timestamp,omitempty"`
+	CreateTimestamp      int64            `protobuf:"varint,10,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+}
+
+func (x *OutboxEntry) Reset() {
+	*x = OutboxEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutboxEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutboxEntry) ProtoMessage() {}
+
+func (x *OutboxEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutboxEntry.ProtoReflect.Descriptor instead.
+func (*OutboxEntry) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OutboxEntry) GetEntryId() uint64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *OutboxEntry) GetState() OutboxEntryState {
+	if x != nil {
+		return x.State
+	}
+	return OutboxEntryState_OUTBOX_ENTRY_STATE_UNSPECIFIED
+}
+
+func (x *OutboxEntry) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetNextAttemptTimestamp() int64 {
+	if x != nil {
+		return x.NextAttemptTimestamp
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *OutboxEntry) GetStuck() bool {
+	if x != nil {
+		return x.Stuck
+	}
+	return false
+}
+
+func (x *OutboxEntry) GetDispatchTimestamp() int64 {
+	if x != nil {
+		return x.DispatchTimestamp
+	}
+	return 0
+}
+
+func (x *OutboxEntry) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+type ListOutboxEntriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string             `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32              `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	States    []OutboxEntryState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=gidyon.mpesastk.OutboxEntryState" json:"states,omitempty"`
+	StuckOnly bool               `protobuf:"varint,4,opt,name=stuck_only,json=stuckOnly,proto3" json:"stuck_only,omitempty"`
+}
+
+func (x *ListOutboxEntriesRequest) Reset() {
+	*x = ListOutboxEntriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutboxEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxEntriesRequest) ProtoMessage() {}
+
+func (x *ListOutboxEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListOutboxEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListOutboxEntriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListOutboxEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOutboxEntriesRequest) GetStates() []OutboxEntryState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListOutboxEntriesRequest) GetStuckOnly() bool {
+	if x != nil {
+		return x.StuckOnly
+	}
+	return false
+}
+
+type ListOutboxEntriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string         `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Entries       []*OutboxEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	PendingCount  int64          `protobuf:"varint,3,opt,name=pending_count,json=pendingCount,proto3" json:"pending_count,omitempty"`
+	StuckCount    int64          `protobuf:"varint,4,opt,name=stuck_count,json=stuckCount,proto3" json:"stuck_count,omitempty"`
+}
+
+func (x *ListOutboxEntriesResponse) Reset() {
+	*x = ListOutboxEntriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOutboxEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutboxEntriesResponse) ProtoMessage() {}
+
+func (x *ListOutboxEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutboxEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListOutboxEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListOutboxEntriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListOutboxEntriesResponse) GetEntries() []*OutboxEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListOutboxEntriesResponse) GetPendingCount() int64 {
+	if x != nil {
+		return x.PendingCount
+	}
+	return 0
+}
+
+func (x *ListOutboxEntriesResponse) GetStuckCount() int64 {
+	if x != nil {
+		return x.StuckCount
+	}
+	return 0
+}
+
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetterId    uint64         `protobuf:"varint,1,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
+	Kind            DeadLetterKind `protobuf:"varint,2,opt,name=kind,proto3,enum=gidyon.mpesastk.DeadLetterKind" json:"kind,omitempty"`
+	Channel         string         `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload         []byte         `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Error           string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Attempts        int32          `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	TransactionId   uint64         `protobuf:"varint,7,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	SourceId        string         `protobuf:"bytes,8,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	CreateTimestamp int64          `protobuf:"varint,9,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+	UpdateTimestamp int64          `protobuf:"varint,10,opt,name=update_timestamp,json=updateTimestamp,proto3" json:"update_timestamp,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeadLetter) GetDeadLetterId() uint64 {
+	if x != nil {
+		return x.DeadLetterId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetKind() DeadLetterKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DeadLetterKind_DEAD_LETTER_KIND_UNSPECIFIED
+}
+
+func (x *DeadLetter) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetUpdateTimestamp() int64 {
+	if x != nil {
+		return x.UpdateTimestamp
+	}
+	return 0
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string           `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Kinds     []DeadLetterKind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=gidyon.mpesastk.DeadLetterKind" json:"kinds,omitempty"`
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetKinds() []DeadLetterKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string        `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	DeadLetters   []*DeadLetter `protobuf:"bytes,2,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	TotalCount    int64         `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListDeadLettersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *ListDeadLettersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RetryDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetterIds []uint64 `protobuf:"varint,1,rep,packed,name=dead_letter_ids,json=deadLetterIds,proto3" json:"dead_letter_ids,omitempty"`
+}
+
+func (x *RetryDeadLettersRequest) Reset() {
+	*x = RetryDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeadLettersRequest) ProtoMessage() {}
+
+func (x *RetryDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*RetryDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RetryDeadLettersRequest) GetDeadLetterIds() []uint64 {
+	if x != nil {
+		return x.DeadLetterIds
+	}
+	return nil
+}
+
+type DeadLetterRetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetterId uint64 `protobuf:"varint,1,opt,name=dead_letter_id,json=deadLetterId,proto3" json:"dead_letter_id,omitempty"`
+	Succeeded    bool   `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DeadLetterRetry) Reset() {
+	*x = DeadLetterRetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetterRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterRetry) ProtoMessage() {}
+
+func (x *DeadLetterRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterRetry.ProtoReflect.Descriptor instead.
+func (*DeadLetterRetry) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeadLetterRetry) GetDeadLetterId() uint64 {
+	if x != nil {
+		return x.DeadLetterId
+	}
+	return 0
+}
+
+func (x *DeadLetterRetry) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *DeadLetterRetry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RetryDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Retries        []*DeadLetterRetry `protobuf:"bytes,1,rep,name=retries,proto3" json:"retries,omitempty"`
+	SucceededCount int32              `protobuf:"varint,2,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	FailedCount    int32              `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+}
+
+func (x *RetryDeadLettersResponse) Reset() {
+	*x = RetryDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryDeadLettersResponse) ProtoMessage() {}
+
+func (x *RetryDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*RetryDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RetryDeadLettersResponse) GetRetries() []*DeadLetterRetry {
+	if x != nil {
+		return x.Retries
+	}
+	return nil
+}
+
+func (x *RetryDeadLettersResponse) GetSucceededCount() int32 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *RetryDeadLettersResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+type PurgeDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetterIds []uint64         `protobuf:"varint,1,rep,packed,name=dead_letter_ids,json=deadLetterIds,proto3" json:"dead_letter_ids,omitempty"`
+	Kinds         []DeadLetterKind `protobuf:"varint,2,rep,packed,name=kinds,proto3,enum=gidyon.mpesastk.DeadLetterKind" json:"kinds,omitempty"`
+	// Removes dead letters created before the timestamp
+	BeforeTimestamp int64 `protobuf:"varint,3,opt,name=before_timestamp,json=beforeTimestamp,proto3" json:"before_timestamp,omitempty"`
+}
+
+func (x *PurgeDeadLettersRequest) Reset() {
+	*x = PurgeDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLettersRequest) ProtoMessage() {}
+
+func (x *PurgeDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PurgeDeadLettersRequest) GetDeadLetterIds() []uint64 {
+	if x != nil {
+		return x.DeadLetterIds
+	}
+	return nil
+}
+
+func (x *PurgeDeadLettersRequest) GetKinds() []DeadLetterKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *PurgeDeadLettersRequest) GetBeforeTimestamp() int64 {
+	if x != nil {
+		return x.BeforeTimestamp
+	}
+	return 0
+}
+
+type PurgeDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PurgedCount int64 `protobuf:"varint,1,opt,name=purged_count,json=purgedCount,proto3" json:"purged_count,omitempty"`
+}
+
+func (x *PurgeDeadLettersResponse) Reset() {
+	*x = PurgeDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeDeadLettersResponse) ProtoMessage() {}
+
+func (x *PurgeDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*PurgeDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PurgeDeadLettersResponse) GetPurgedCount() int64 {
+	if x != nil {
+		return x.PurgedCount
+	}
+	return 0
+}
+
+// StkProcessRequest is sent on the process channel, as json or protobuf, to set processed state of a
+// stk transaction
+type StkProcessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the message; only version 1 is supported
+	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Identifies the request in its ack
+	RequestId      string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TransactionId  uint64 `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MpesaReceiptId string `protobuf:"bytes,4,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	Processed      bool   `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	ProcessorId    string `protobuf:"bytes,6,opt,name=processor_id,json=processorId,proto3" json:"processor_id,omitempty"`
+	ProcessorNames string `protobuf:"bytes,7,opt,name=processor_names,json=processorNames,proto3" json:"processor_names,omitempty"`
+	Note           string `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	// Channel that receives the ack; defaults to the configured process reply channel
+	ReplyChannel string `protobuf:"bytes,9,opt,name=reply_channel,json=replyChannel,proto3" json:"reply_channel,omitempty"`
+}
+
+func (x *StkProcessRequest) Reset() {
+	*x = StkProcessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkProcessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkProcessRequest) ProtoMessage() {}
+
+func (x *StkProcessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkProcessRequest.ProtoReflect.Descriptor instead.
+func (*StkProcessRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StkProcessRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *StkProcessRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StkProcessRequest) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *StkProcessRequest) GetMpesaReceiptId() string {
+	if x != nil {
+		return x.MpesaReceiptId
+	}
+	return ""
+}
+
+func (x *StkProcessRequest) GetProcessed() bool {
+	if x != nil {
+		return x.Processed
+	}
+	return false
+}
+
+func (x *StkProcessRequest) GetProcessorId() string {
+	if x != nil {
+		return x.ProcessorId
+	}
+	return ""
+}
+
+func (x *StkProcessRequest) GetProcessorNames() string {
+	if x != nil {
+		return x.ProcessorNames
+	}
+	return ""
+}
+
+func (x *StkProcessRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *StkProcessRequest) GetReplyChannel() string {
+	if x != nil {
+		return x.ReplyChannel
+	}
+	return ""
+}
+
+// StkProcessAck is published to the reply channel once a process request is applied or given up on
+type StkProcessAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version        int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	RequestId      string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TransactionId  uint64 `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MpesaReceiptId string `protobuf:"bytes,4,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	Processed      bool   `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	Succeeded      bool   `protobuf:"varint,6,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Error          string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	AckTimestamp   int64  `protobuf:"varint,8,opt,name=ack_timestamp,json=ackTimestamp,proto3" json:"ack_timestamp,omitempty"`
+}
+
+func (x *StkProcessAck) Reset() {
+	*x = StkProcessAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkProcessAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkProcessAck) ProtoMessage() {}
+
+func (x *StkProcessAck) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkProcessAck.ProtoReflect.Descriptor instead.
+func (*StkProcessAck) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StkProcessAck) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *StkProcessAck) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *StkProcessAck) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *StkProcessAck) GetMpesaReceiptId() string {
+	if x != nil {
+		return x.MpesaReceiptId
+	}
+	return ""
+}
+
+func (x *StkProcessAck) GetProcessed() bool {
+	if x != nil {
+		return x.Processed
+	}
+	return false
+}
+
+func (x *StkProcessAck) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *StkProcessAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StkProcessAck) GetAckTimestamp() int64 {
+	if x != nil {
+		return x.AckTimestamp
+	}
+	return 0
+}
+
+type GetStkStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListStkTransactionFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Size of time buckets; defaults to day
+	Interval StkStatsInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=gidyon.mpesastk.StkStatsInterval" json:"interval,omitempty"`
+	// IANA time zone of time buckets; defaults to UTC
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetStkStatsRequest) Reset() {
+	*x = GetStkStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStkStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStkStatsRequest) ProtoMessage() {}
+
+func (x *GetStkStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStkStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStkStatsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetStkStatsRequest) GetFilter() *ListStkTransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetStkStatsRequest) GetInterval() StkStatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StkStatsInterval_STK_STATS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetStkStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// StkStatsGroup aggregates stk transactions sharing a key
+type StkStatsGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key             string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count           int64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Amount          float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	SucceededCount  int64   `protobuf:"varint,4,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	SucceededAmount float64 `protobuf:"fixed64,5,opt,name=succeeded_amount,json=succeededAmount,proto3" json:"succeeded_amount,omitempty"`
+}
+
+func (x *StkStatsGroup) Reset() {
+	*x = StkStatsGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkStatsGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkStatsGroup) ProtoMessage() {}
+
+func (x *StkStatsGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkStatsGroup.ProtoReflect.Descriptor instead.
+func (*StkStatsGroup) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StkStatsGroup) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StkStatsGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StkStatsGroup) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StkStatsGroup) GetSucceededCount() int64 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *StkStatsGroup) GetSucceededAmount() float64 {
+	if x != nil {
+		return x.SucceededAmount
+	}
+	return 0
+}
+
+type StkStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Amount          float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	SucceededCount  int64   `protobuf:"varint,3,opt,name=succeeded_count,json=succeededCount,proto3" json:"succeeded_count,omitempty"`
+	SucceededAmount float64 `protobuf:"fixed64,4,opt,name=succeeded_amount,json=succeededAmount,proto3" json:"succeeded_amount,omitempty"`
+	FailedCount     int64   `protobuf:"varint,5,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	// Share of transactions in a final status that succeeded, from 0 to 1
+	SuccessRate float64 `protobuf:"fixed64,6,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// Average seconds from stk push to its callback
+	AverageCallbackSeconds float64          `protobuf:"fixed64,7,opt,name=average_callback_seconds,json=averageCallbackSeconds,proto3" json:"average_callback_seconds,omitempty"`
+	ByStatus               []*StkStatsGroup `protobuf:"bytes,8,rep,name=by_status,json=byStatus,proto3" json:"by_status,omitempty"`
+	ByShortCode            []*StkStatsGroup `protobuf:"bytes,9,rep,name=by_short_code,json=byShortCode,proto3" json:"by_short_code,omitempty"`
+	ByInitiator            []*StkStatsGroup `protobuf:"bytes,10,rep,name=by_initiator,json=byInitiator,proto3" json:"by_initiator,omitempty"`
+	// Keyed by start of the time bucket in RFC3339 format
+	ByTime []*StkStatsGroup `protobuf:"bytes,11,rep,name=by_time,json=byTime,proto3" json:"by_time,omitempty"`
+	// Failed transactions keyed by result code
+	ByResultCode []*StkStatsGroup `protobuf:"bytes,12,rep,name=by_result_code,json=byResultCode,proto3" json:"by_result_code,omitempty"`
+	Interval     StkStatsInterval `protobuf:"varint,13,opt,name=interval,proto3,enum=gidyon.mpesastk.StkStatsInterval" json:"interval,omitempty"`
+	TimeZone     string           `protobuf:"bytes,14,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *StkStats) Reset() {
+	*x = StkStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StkStats) ProtoMessage() {}
+
+func (x *StkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StkStats.ProtoReflect.Descriptor instead.
+func (*StkStats) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StkStats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StkStats) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StkStats) GetSucceededCount() int64 {
+	if x != nil {
+		return x.SucceededCount
+	}
+	return 0
+}
+
+func (x *StkStats) GetSucceededAmount() float64 {
+	if x != nil {
+		return x.SucceededAmount
+	}
+	return 0
+}
+
+func (x *StkStats) GetFailedCount() int64 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *StkStats) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *StkStats) GetAverageCallbackSeconds() float64 {
+	if x != nil {
+		return x.AverageCallbackSeconds
+	}
+	return 0
+}
+
+func (x *StkStats) GetByStatus() []*StkStatsGroup {
+	if x != nil {
+		return x.ByStatus
+	}
+	return nil
+}
+
+func (x *StkStats) GetByShortCode() []*StkStatsGroup {
+	if x != nil {
+		return x.ByShortCode
+	}
+	return nil
+}
+
+func (x *StkStats) GetByInitiator() []*StkStatsGroup {
+	if x != nil {
+		return x.ByInitiator
+	}
+	return nil
+}
+
+func (x *StkStats) GetByTime() []*StkStatsGroup {
+	if x != nil {
+		return x.ByTime
+	}
+	return nil
+}
+
+func (x *StkStats) GetByResultCode() []*StkStatsGroup {
+	if x != nil {
+		return x.ByResultCode
+	}
+	return nil
+}
+
+func (x *StkStats) GetInterval() StkStatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StkStatsInterval_STK_STATS_INTERVAL_UNSPECIFIED
+}
+
+func (x *StkStats) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ExportStkTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ListStkTransactionFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Defaults to csv
+	Format ExportFormat `protobuf:"varint,2,opt,name=format,proto3,enum=gidyon.mpesastk.ExportFormat" json:"format,omitempty"`
+	// Columns in the file, in order; defaults to all columns
+	Columns []string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// IANA time zone of timestamps in the file; defaults to UTC
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *ExportStkTransactionsRequest) Reset() {
+	*x = ExportStkTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStkTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStkTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportStkTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStkTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStkTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExportStkTransactionsRequest) GetFilter() *ListStkTransactionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportStkTransactionsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportStkTransactionsRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportStkTransactionsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// ExportStkTransactionsChunk is the next part of an exported file
+type ExportStkTransactionsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Content type and file name are set on the first chunk
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName    string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *ExportStkTransactionsChunk) Reset() {
+	*x = ExportStkTransactionsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportStkTransactionsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStkTransactionsChunk) ProtoMessage() {}
+
+func (x *ExportStkTransactionsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStkTransactionsChunk.ProtoReflect.Descriptor instead.
+func (*ExportStkTransactionsChunk) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExportStkTransactionsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportStkTransactionsChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportStkTransactionsChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type ExportJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId   uint64                        `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	ActorId string                        `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	State   ExportJobState                `protobuf:"varint,3,opt,name=state,proto3,enum=gidyon.mpesastk.ExportJobState" json:"state,omitempty"`
+	Export  *ExportStkTransactionsRequest `protobuf:"bytes,4,opt,name=export,proto3" json:"export,omitempty"`
+	// Number of transactions matching the filter when the job started
+	TotalRows    int64 `protobuf:"varint,5,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ExportedRows int64 `protobuf:"varint,6,opt,name=exported_rows,json=exportedRows,proto3" json:"exported_rows,omitempty"`
+	// Share of transactions exported, from 0 to 1
+	Progress float64 `protobuf:"fixed64,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	FileName string  `protobuf:"bytes,8,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Content type of the file before compression
+	ContentType string `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Size of the gzip compressed file
+	SizeBytes int64 `protobuf:"varint,10,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Signed link for downloading the gzip compressed file; set once the job completes
+	DownloadUrl                string `protobuf:"bytes,11,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DownloadUrlExpireTimestamp int64  `protobuf:"varint,12,opt,name=download_url_expire_timestamp,json=downloadUrlExpireTimestamp,proto3" json:"download_url_expire_timestamp,omitempty"`
+	Error                      string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTimestamp            int64  `protobuf:"varint,14,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+	StartTimestamp             int64  `protobuf:"varint,15,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	CompleteTimestamp          int64  `protobuf:"varint,16,opt,name=complete_timestamp,json=completeTimestamp,proto3" json:"complete_timestamp,omitempty"`
+}
+
+func (x *ExportJob) Reset() {
+	*x = ExportJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJob) ProtoMessage() {}
+
+func (x *ExportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJob.ProtoReflect.Descriptor instead.
+func (*ExportJob) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportJob) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ExportJob) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ExportJob) GetState() ExportJobState {
+	if x != nil {
+		return x.State
+	}
+	return ExportJobState_EXPORT_JOB_STATE_UNSPECIFIED
+}
+
+func (x *ExportJob) GetExport() *ExportStkTransactionsRequest {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *ExportJob) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ExportJob) GetExportedRows() int64 {
+	if x != nil {
+		return x.ExportedRows
+	}
+	return 0
+}
+
+func (x *ExportJob) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *ExportJob) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportJob) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportJob) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ExportJob) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ExportJob) GetDownloadUrlExpireTimestamp() int64 {
+	if x != nil {
+		return x.DownloadUrlExpireTimestamp
+	}
+	return 0
+}
+
+func (x *ExportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExportJob) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+func (x *ExportJob) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *ExportJob) GetCompleteTimestamp() int64 {
+	if x != nil {
+		return x.CompleteTimestamp
+	}
+	return 0
+}
+
+type CreateExportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Export *ExportStkTransactionsRequest `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *CreateExportJobRequest) Reset() {
+	*x = CreateExportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateExportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExportJobRequest) ProtoMessage() {}
+
+func (x *CreateExportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExportJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateExportJobRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateExportJobRequest) GetExport() *ExportStkTransactionsRequest {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetExportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetExportJobRequest) Reset() {
+	*x = GetExportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetExportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExportJobRequest) ProtoMessage() {}
+
+func (x *GetExportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetExportJobRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetExportJobRequest) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type ListExportJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string           `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	States    []ExportJobState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=gidyon.mpesastk.ExportJobState" json:"states,omitempty"`
+}
+
+func (x *ListExportJobsRequest) Reset() {
+	*x = ListExportJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportJobsRequest) ProtoMessage() {}
+
+func (x *ListExportJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListExportJobsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListExportJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListExportJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExportJobsRequest) GetStates() []ExportJobState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type ListExportJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string       `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ExportJobs    []*ExportJob `protobuf:"bytes,2,rep,name=export_jobs,json=exportJobs,proto3" json:"export_jobs,omitempty"`
+}
+
+func (x *ListExportJobsResponse) Reset() {
+	*x = ListExportJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListExportJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExportJobsResponse) ProtoMessage() {}
+
+func (x *ListExportJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExportJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListExportJobsResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListExportJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListExportJobsResponse) GetExportJobs() []*ExportJob {
+	if x != nil {
+		return x.ExportJobs
+	}
+	return nil
+}
+
+type Reconciliation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReconciliationId uint64 `protobuf:"varint,1,opt,name=reconciliation_id,json=reconciliationId,proto3" json:"reconciliation_id,omitempty"`
+	ActorId          string `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ShortCode        string `protobuf:"bytes,3,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	FileName         string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Period covered by the statement
+	StartTimestamp          int64 `protobuf:"varint,5,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp            int64 `protobuf:"varint,6,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	StatementRows           int64 `protobuf:"varint,7,opt,name=statement_rows,json=statementRows,proto3" json:"statement_rows,omitempty"`
+	MatchedCount            int64 `protobuf:"varint,8,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	MissingInDbCount        int64 `protobuf:"varint,9,opt,name=missing_in_db_count,json=missingInDbCount,proto3" json:"missing_in_db_count,omitempty"`
+	MissingInStatementCount int64 `protobuf:"varint,10,opt,name=missing_in_statement_count,json=missingInStatementCount,proto3" json:"missing_in_statement_count,omitempty"`
+	AmountMismatchCount     int64 `protobuf:"varint,11,opt,name=amount_mismatch_count,json=amountMismatchCount,proto3" json:"amount_mismatch_count,omitempty"`
+	StatusMismatchCount     int64 `protobuf:"varint,12,opt,name=status_mismatch_count,json=statusMismatchCount,proto3" json:"status_mismatch_count,omitempty"`
+	CorrectedCount          int64 `protobuf:"varint,13,opt,name=corrected_count,json=correctedCount,proto3" json:"corrected_count,omitempty"`
+	CreateTimestamp         int64 `protobuf:"varint,14,opt,name=create_timestamp,json=createTimestamp,proto3" json:"create_timestamp,omitempty"`
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Reconciliation) GetReconciliationId() uint64 {
+	if x != nil {
+		return x.ReconciliationId
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetStatementRows() int64 {
+	if x != nil {
+		return x.StatementRows
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetMatchedCount() int64 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetMissingInDbCount() int64 {
+	if x != nil {
+		return x.MissingInDbCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetMissingInStatementCount() int64 {
+	if x != nil {
+		return x.MissingInStatementCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetAmountMismatchCount() int64 {
+	if x != nil {
+		return x.AmountMismatchCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetStatusMismatchCount() int64 {
+	if x != nil {
+		return x.StatusMismatchCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetCorrectedCount() int64 {
+	if x != nil {
+		return x.CorrectedCount
+	}
+	return 0
+}
+
+func (x *Reconciliation) GetCreateTimestamp() int64 {
+	if x != nil {
+		return x.CreateTimestamp
+	}
+	return 0
+}
+
+type ReconciliationItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId               uint64                 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Category             ReconciliationCategory `protobuf:"varint,2,opt,name=category,proto3,enum=gidyon.mpesastk.ReconciliationCategory" json:"category,omitempty"`
+	MpesaReceiptId       string                 `protobuf:"bytes,3,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	TransactionId        uint64                 `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	StatementAmount      float64                `protobuf:"fixed64,5,opt,name=statement_amount,json=statementAmount,proto3" json:"statement_amount,omitempty"`
+	TransactionAmount    float64                `protobuf:"fixed64,6,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"`
+	PhoneNumber          string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	StatementTimestamp   int64                  `protobuf:"varint,8,opt,name=statement_timestamp,json=statementTimestamp,proto3" json:"statement_timestamp,omitempty"`
+	TransactionTimestamp int64                  `protobuf:"varint,9,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
+	Note                 string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	CorrectTimestamp     int64                  `protobuf:"varint,11,opt,name=correct_timestamp,json=correctTimestamp,proto3" json:"correct_timestamp,omitempty"`
+}
+
+func (x *ReconciliationItem) Reset() {
+	*x = ReconciliationItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationItem) ProtoMessage() {}
+
+func (x *ReconciliationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationItem.ProtoReflect.Descriptor instead.
+func (*ReconciliationItem) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReconciliationItem) GetItemId() uint64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetCategory() ReconciliationCategory {
+	if x != nil {
+		return x.Category
+	}
+	return ReconciliationCategory_RECONCILIATION_CATEGORY_UNSPECIFIED
+}
+
+func (x *ReconciliationItem) GetMpesaReceiptId() string {
+	if x != nil {
+		return x.MpesaReceiptId
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetStatementAmount() float64 {
+	if x != nil {
+		return x.StatementAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetTransactionAmount() float64 {
+	if x != nil {
+		return x.TransactionAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetStatementTimestamp() int64 {
+	if x != nil {
+		return x.StatementTimestamp
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetTransactionTimestamp() int64 {
+	if x != nil {
+		return x.TransactionTimestamp
+	}
+	return 0
+}
+
+func (x *ReconciliationItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReconciliationItem) GetCorrectTimestamp() int64 {
+	if x != nil {
+		return x.CorrectTimestamp
+	}
+	return 0
+}
+
+type ReconciliationReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reconciliation *Reconciliation       `protobuf:"bytes,1,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`
+	Items          []*ReconciliationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken  string                `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ReconciliationReport) Reset() {
+	*x = ReconciliationReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconciliationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationReport) ProtoMessage() {}
+
+func (x *ReconciliationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationReport.ProtoReflect.Descriptor instead.
+func (*ReconciliationReport) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReconciliationReport) GetReconciliation() *Reconciliation {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+func (x *ReconciliationReport) GetItems() []*ReconciliationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReconciliationReport) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RunReconciliationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Statement csv exported from the M-Pesa organisation portal
+	Statement []byte `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
+	FileName  string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Short code of the statement; read from the statement when not set
+	ShortCode string `protobuf:"bytes,3,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	// How far apart a payment and the stk push without a receipt may be to match; defaults to 5 minutes
+	TimeToleranceSeconds int64 `protobuf:"varint,4,opt,name=time_tolerance_seconds,json=timeToleranceSeconds,proto3" json:"time_tolerance_seconds,omitempty"`
+}
+
+func (x *RunReconciliationRequest) Reset() {
+	*x = RunReconciliationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReconciliationRequest) ProtoMessage() {}
+
+func (x *RunReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*RunReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RunReconciliationRequest) GetStatement() []byte {
+	if x != nil {
+		return x.Statement
+	}
+	return nil
+}
+
+func (x *RunReconciliationRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *RunReconciliationRequest) GetShortCode() string {
+	if x != nil {
+		return x.ShortCode
+	}
+	return ""
+}
+
+func (x *RunReconciliationRequest) GetTimeToleranceSeconds() int64 {
+	if x != nil {
+		return x.TimeToleranceSeconds
+	}
+	return 0
+}
+
+type GetReconciliationReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReconciliationId uint64                   `protobuf:"varint,1,opt,name=reconciliation_id,json=reconciliationId,proto3" json:"reconciliation_id,omitempty"`
+	Categories       []ReconciliationCategory `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=gidyon.mpesastk.ReconciliationCategory" json:"categories,omitempty"`
+	PageToken        string                   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize         int32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetReconciliationReportRequest) Reset() {
+	*x = GetReconciliationReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReconciliationReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationReportRequest) ProtoMessage() {}
+
+func (x *GetReconciliationReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationReportRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetReconciliationReportRequest) GetReconciliationId() uint64 {
+	if x != nil {
+		return x.ReconciliationId
+	}
+	return 0
+}
+
+func (x *GetReconciliationReportRequest) GetCategories() []ReconciliationCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetReconciliationReportRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetReconciliationReportRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CorrectReconciliationItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReconciliationId uint64 `protobuf:"varint,1,opt,name=reconciliation_id,json=reconciliationId,proto3" json:"reconciliation_id,omitempty"`
+	// Status mismatch items to correct; all of them when empty
+	ItemIds []uint64 `protobuf:"varint,2,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+}
+
+func (x *CorrectReconciliationItemsRequest) Reset() {
+	*x = CorrectReconciliationItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrectReconciliationItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectReconciliationItemsRequest) ProtoMessage() {}
+
+func (x *CorrectReconciliationItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectReconciliationItemsRequest.ProtoReflect.Descriptor instead.
+func (*CorrectReconciliationItemsRequest) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CorrectReconciliationItemsRequest) GetReconciliationId() uint64 {
+	if x != nil {
+		return x.ReconciliationId
+	}
+	return 0
+}
+
+func (x *CorrectReconciliationItemsRequest) GetItemIds() []uint64 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type CorrectReconciliationItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrectedCount int64    `protobuf:"varint,1,opt,name=corrected_count,json=correctedCount,proto3" json:"corrected_count,omitempty"`
+	FailedItemIds  []uint64 `protobuf:"varint,2,rep,packed,name=failed_item_ids,json=failedItemIds,proto3" json:"failed_item_ids,omitempty"`
+}
+
+func (x *CorrectReconciliationItemsResponse) Reset() {
+	*x = CorrectReconciliationItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stk_v1_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CorrectReconciliationItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorrectReconciliationItemsResponse) ProtoMessage() {}
+
+func (x *CorrectReconciliationItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stk_v1_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorrectReconciliationItemsResponse.ProtoReflect.Descriptor instead.
+func (*CorrectReconciliationItemsResponse) Descriptor() ([]byte, []int) {
+	return file_stk_v1_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CorrectReconciliationItemsResponse) GetCorrectedCount() int64 {
+	if x != nil {
+		return x.CorrectedCount
+	}
+	return 0
+}
+
+func (x *CorrectReconciliationItemsResponse) GetFailedItemIds() []uint64 {
+	if x != nil {
+		return x.FailedItemIds
+	}
+	return nil
+}
+
+var File_stk_v1_proto protoreflect.FileDescriptor
+
+var file_stk_v1_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x73, 0x74, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x75, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x0a, 0x0a, 0x0e, 0x53, 0x74, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x73, 0x63, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x74, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x74, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a,
+	0x11, 0x73, 0x74, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6b,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6b, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72, 0x61, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45,
+	0x78, 0x74, 0x72, 0x61, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x1a, 0x41, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0x30, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0x2a, 0x0e, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x19, 0x53, 0x74, 0x6b, 0x20,
+	0x50, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0xfa, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x43, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa6, 0x02, 0x0a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54,
+	0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x31, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63,
+	0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x44, 0x75, 0x72, 0x3a, 0x5e, 0x92, 0x41, 0x5b,
+	0x0a, 0x59, 0x2a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54, 0x4b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
+	0x68, 0x61, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x20, 0x73, 0x74,
+	0x6b, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x0c, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x12,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x11,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x10, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x77, 0x61,
+	0x69, 0x74, 0x44, 0x75, 0x72, 0x3a, 0x5e, 0x92, 0x41, 0x5b, 0x0a, 0x59, 0x2a, 0x11, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
+	0x24, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x69,
+	0x6c, 0x6c, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x6f, 0x20, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0xd2, 0x01, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xda, 0x06, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0c,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x1f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a,
+	0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x12, 0x45, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x75, 0x92, 0x41, 0x72,
+	0x0a, 0x70, 0x2a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x3a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x73, 0x20, 0x61, 0x20, 0x53, 0x54, 0x4b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0xd2, 0x01, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0xd2, 0x01, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53,
+	0x54, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x48,
+	0x0a, 0x0f, 0x73, 0x74, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x3a,
+	0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x39, 0x2a, 0x13, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x53, 0x54, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x22, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x53, 0x54, 0x4b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x22,
+	0xc2, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x3a, 0x55, 0x92,
+	0x41, 0x52, 0x0a, 0x50, 0x2a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x23,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x65, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0xd2, 0x01, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x22, 0xcc, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x69, 0x74, 0x53, 0x74, 0x6b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x3a, 0x5e, 0x92, 0x41, 0x5b, 0x0a, 0x59, 0x2a, 0x14, 0x57, 0x61, 0x69, 0x74,
+	0x53, 0x74, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x61, 0x69,
+	0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0xd2, 0x01, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x22, 0xac, 0x03, 0x0a, 0x13, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x6f,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x3a, 0x3c, 0x92, 0x41, 0x39, 0x0a, 0x37, 0x2a, 0x13, 0x53, 0x74, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x32, 0x20, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x3a,
+	0x6e, 0x92, 0x41, 0x6b, 0x0a, 0x69, 0x2a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x35, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x73,
+	0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0x01,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22,
+	0xe8, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3c, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53,
+	0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x5e, 0x92, 0x41, 0x5b, 0x0a,
+	0x59, 0x2a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x35, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x50, 0x92, 0x41, 0x4d,
+	0x0a, 0x4b, 0x2a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
+	0x22, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0xd2, 0x01, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xdc, 0x08,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x20, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x1e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3f, 0x0a, 0x0b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b,
+	0x2e, 0x53, 0x74, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x50, 0x0a, 0x11, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0a,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4b,
+	0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x3a, 0x49, 0x92, 0x41, 0x46, 0x0a, 0x44, 0x2a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x32, 0x28, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20,
+	0x73, 0x74, 0x6b, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xb3, 0x02, 0x0a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x58, 0x92, 0x41, 0x55, 0x0a, 0x53, 0x2a,
+	0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x35, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+	0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x22, 0x96, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4a, 0x0a, 0x10, 0x73, 0x74,
+	0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x58, 0x92, 0x41, 0x55, 0x0a, 0x53, 0x2a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x34, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75,
+	0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x1b,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x3a, 0x4d,
+	0x92, 0x41, 0x4a, 0x0a, 0x48, 0x2a, 0x1b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x29, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x02,
+	0x0a, 0x1c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x73, 0x74, 0x6b, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0e, 0x73, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x61, 0x92, 0x41, 0x5e, 0x0a,
+	0x5c, 0x2a, 0x1c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x3c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20,
+	0x77, 0x61, 0x73, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xde, 0x01,
+	0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x3a, 0x4f, 0x92,
+	0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x1c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x2a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x87,
+	0x02, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4e, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x4a, 0x92, 0x41,
+	0x47, 0x0a, 0x45, 0x2a, 0x1c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x25, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x61, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68,
+	0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xef, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x3a,
+	0x39, 0x92, 0x41, 0x36, 0x0a, 0x34, 0x2a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x22, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f,
+	0x20, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x0a, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x69,
+	0x6e, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x09, 0x74, 0x65, 0x78, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x65, 0x78, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x68,
+	0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x9a, 0x02, 0x0a, 0x18, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x63, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0a, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x63, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x09, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x08, 0x63, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x07, 0x63, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x06, 0x63,
+	0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x65, 0x55, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x72,
+	0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x63, 0x65, 0x55, 0x72,
+	0x69, 0x52, 0x65, 0x66, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x22, 0xc1, 0x04,
+	0x0a, 0x0b, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x72,
+	0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a, 0x34, 0x2a, 0x0b, 0x53,
+	0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x32, 0x25, 0x52, 0x61, 0x77, 0x20,
+	0x73, 0x74, 0x6b, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x64, 0x61, 0x72, 0x61, 0x6a,
+	0x61, 0x22, 0x8c, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x49, 0x64, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0x2a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0xd2, 0x01, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x22, 0x95, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x3b, 0x2a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x32, 0x21, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x63,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x3a, 0x51, 0x92, 0x41, 0x4e, 0x0a, 0x4c, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53,
+	0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x09, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x73, 0x3a, 0x52, 0x92, 0x41, 0x4f, 0x0a, 0x4d, 0x2a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x16, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x7b, 0x92, 0x41, 0x78, 0x0a,
+	0x76, 0x2a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x2d, 0x72, 0x75, 0x6e, 0x20, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x73, 0x2e, 0x20, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x68,
+	0x61, 0x76, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f,
+	0x72, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x20, 0x69, 0x64, 0x73, 0xd2, 0x01,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x0e, 0x53, 0x74, 0x6b, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdc, 0x03, 0x0a, 0x11, 0x53, 0x74,
+	0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
+	0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38,
+	0x2a, 0x11, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x32, 0x23, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x73, 0x74, 0x6b, 0x20,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0x80, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x73, 0x3a, 0x46, 0x92, 0x41, 0x43, 0x0a, 0x41, 0x2a, 0x17, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x26, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x20, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74,
+	0x6b, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0c, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x3a, 0x8d, 0x01, 0x92, 0x41, 0x89, 0x01, 0x0a, 0x86, 0x01, 0x2a, 0x1a, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x74, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x20, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0xd2, 0x01, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75,
+	0x72, 0x6c, 0x22, 0xd0, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x35,
+	0x92, 0x41, 0x32, 0x0a, 0x30, 0x2a, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x32, 0x1c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xb2, 0x01, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xf1, 0x04, 0x0a, 0x0f, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6e,
+	0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c,
+	0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x0f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x40, 0x92, 0x41,
+	0x3d, 0x0a, 0x3b, 0x2a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x32, 0x28, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x20, 0x6f,
+	0x66, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0xaa,
+	0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x3a, 0x5b,
+	0x92, 0x41, 0x58, 0x0a, 0x56, 0x2a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x32, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x20, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x5c, 0x92, 0x41, 0x59, 0x0a, 0x57, 0x2a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x36, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x6f, 0x66, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x3a, 0x58, 0x92, 0x41,
+	0x55, 0x0a, 0x53, 0x2a, 0x1b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x26, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x65, 0x6e,
+	0x64, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0xd2, 0x01, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x22, 0xd3, 0x03, 0x0a, 0x0b, 0x4f, 0x75, 0x74, 0x62, 0x6f,
+	0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x75, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x75,
+	0x63, 0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x4e, 0x92, 0x41,
+	0x4b, 0x0a, 0x49, 0x2a, 0x0b, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x32, 0x3a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x20, 0x73, 0x61, 0x76, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x85, 0x02, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x63, 0x6b, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x75, 0x63, 0x6b, 0x4f, 0x6e, 0x6c, 0x79, 0x3a,
+	0x53, 0x92, 0x41, 0x50, 0x0a, 0x4e, 0x2a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62,
+	0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x97, 0x02, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4f, 0x75,
+	0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x63, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74,
+	0x75, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x32, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20,
+	0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20,
+	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xac,
+	0x03, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a,
+	0x0e, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a,
+	0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x43, 0x92, 0x41, 0x40, 0x0a, 0x3e, 0x2a,
+	0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x32, 0x30, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x63, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x20, 0x6f, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0xdc, 0x01,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x3a, 0x4f, 0x92, 0x41, 0x4c,
+	0x0a, 0x4a, 0x2a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x30, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20,
+	0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20,
+	0x64, 0x65, 0x61, 0x64, 0x20, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0xf4, 0x01, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x3e, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x50, 0x92, 0x41, 0x4d, 0x0a, 0x4b, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x30, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x61, 0x64, 0x20, 0x6c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0d,
+	0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x3a, 0x62, 0x92,
+	0x41, 0x5f, 0x0a, 0x5d, 0x2a, 0x17, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x30, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x20, 0x6f, 0x72, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x20, 0x64, 0x65, 0x61,
+	0x64, 0x20, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e, 0xd2,
+	0x01, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x22, 0x6b, 0x0a, 0x0f, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf9,
+	0x01, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50, 0x2a, 0x18, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x34, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x64, 0x65,
+	0x61, 0x64, 0x20, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x83, 0x02, 0x0a, 0x17, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0d, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x35,
+	0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x3a, 0x5e, 0x92, 0x41, 0x5b, 0x0a, 0x59, 0x2a, 0x17, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x3e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x20, 0x64, 0x65, 0x61, 0x64, 0x20, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61,
+	0x22, 0x3d, 0x0a, 0x18, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xc0, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x22, 0x90, 0x02, 0x0a, 0x0d, 0x53, 0x74, 0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x41, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x89, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x3a, 0x54, 0x92, 0x41, 0x51,
+	0x0a, 0x4f, 0x2a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x39, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x74, 0x6f, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x20, 0x73, 0x74, 0x6b,
+	0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe7, 0x05, 0x0a, 0x08, 0x53, 0x74, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x62, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x08, 0x62, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x62, 0x79, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x62, 0x79, 0x53, 0x68,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x62, 0x79, 0x5f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x62,
+	0x79, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x07, 0x62, 0x79,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x62, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x62, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x3a, 0x3a, 0x92, 0x41, 0x37, 0x0a, 0x35, 0x2a, 0x08, 0x53, 0x74,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x32, 0x29, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xac, 0x02, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x3a, 0x5b, 0x92, 0x41, 0x58, 0x0a, 0x56, 0x2a, 0x1c, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x73, 0x74, 0x6b, 0x20,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x22, 0x70, 0x0a, 0x1a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0xc1, 0x05, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x52, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x72, 0x6c, 0x12, 0x41, 0x0a, 0x1d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x75,
+	0x72, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x46,
+	0x92, 0x41, 0x43, 0x0a, 0x41, 0x2a, 0x09, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x32, 0x34, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x72, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x63, 0x6b,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4b, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x3a, 0x5c,
+	0x92, 0x41, 0x59, 0x0a, 0x57, 0x2a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x34, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0xd2, 0x01, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x7a, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x3a, 0x46, 0x92, 0x41, 0x43, 0x0a, 0x41, 0x2a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x21, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x6a, 0x6f, 0x62, 0xd2,
+	0x01, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x4d, 0x92, 0x41, 0x4a, 0x0a, 0x48, 0x2a, 0x15,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x20, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x7d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0xa6, 0x05, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x77,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x44, 0x62,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x4e,
+	0x92, 0x41, 0x4b, 0x0a, 0x49, 0x2a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x37, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x4d, 0x2d, 0x50, 0x65, 0x73, 0x61, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x73, 0x74,
+	0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe7,
+	0x03, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x43,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xc2, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63,
+	0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x47, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x94, 0x02,
+	0x0a, 0x18, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65,
+	0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x3a, 0x62, 0x92, 0x41, 0x5f, 0x0a, 0x5d, 0x2a, 0x18, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x35, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x4d, 0x2d, 0x50, 0x65, 0x73,
+	0x61, 0x20, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b,
+	0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x3a,
+	0x66, 0x92, 0x41, 0x63, 0x0a, 0x61, 0x2a, 0x1e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0xd2, 0x01, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x21, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x10,
+	0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x3a, 0x7e, 0x92, 0x41, 0x7b,
+	0x0a, 0x79, 0x2a, 0x21, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x40, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x6d, 0x61, 0x72, 0x6b, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x20, 0x70, 0x61, 0x69, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x73, 0x20, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0xd2, 0x01, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x75, 0x0a, 0x22, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x73, 0x2a, 0xbe, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x4b, 0x5f,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
+	0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
+	0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x54, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
+	0x53, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x07, 0x2a, 0x76, 0x0a, 0x12, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x54, 0x4b,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x42,
+	0x49, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x59, 0x5f, 0x47, 0x4f, 0x4f,
+	0x44, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x2a, 0xca, 0x01, 0x0a, 0x0e,
+	0x53, 0x74, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
+	0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10,
+	0x53, 0x54, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59,
+	0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x4b,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x54, 0x4b,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c,
+	0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x2a, 0x61, 0x0a, 0x0d, 0x53, 0x74, 0x6b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x4b,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x01,
+	0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x11, 0x53,
+	0x74, 0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x1d, 0x53, 0x54, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45,
+	0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4b, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x62, 0x0a,
+	0x11, 0x53, 0x74, 0x6b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x4b, 0x5f, 0x53,
+	0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54,
+	0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0x3f, 0x0a, 0x0c, 0x53, 0x74, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45,
+	0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54,
+	0x4b, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x2a, 0x4f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10,
+	0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x45,
+	0x57, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x17, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
+	0x0a, 0x1a, 0x53, 0x54, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x54, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53,
+	0x54, 0x4b, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xbd, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x6b,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a,
+	0x1e, 0x53, 0x54, 0x4b, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x4b, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43,
+	0x4b, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x53, 0x54, 0x4b, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x4b, 0x5f,
+	0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41,
+	0x54, 0x45, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x4b, 0x5f, 0x43, 0x41, 0x4c, 0x4c,
+	0x42, 0x41, 0x43, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x19, 0x0a,
+	0x15, 0x53, 0x54, 0x4b, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x42, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x99, 0x01, 0x0a, 0x14, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c,
+	0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x45, 0x42,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45, 0x42, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45, 0x42, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x03, 0x2a, 0x7b, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x55, 0x54, 0x42,
+	0x4f, 0x58, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x58, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41,
+	0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x55, 0x54, 0x42, 0x4f,
+	0x58, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10,
+	0x03, 0x2a, 0x6c, 0x0a, 0x0e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54,
+	0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45,
+	0x54, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x2a,
+	0x72, 0x0a, 0x10, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x54, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4d, 0x4f, 0x4e, 0x54,
+	0x48, 0x10, 0x03, 0x2a, 0x61, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x53, 0x56,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x44, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x58, 0x4c, 0x53, 0x58, 0x10, 0x03, 0x2a, 0xab, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f,
+	0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0xf0, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x27, 0x0a, 0x23, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x43, 0x4f,
+	0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c,
+	0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x49,
+	0x4e, 0x5f, 0x44, 0x42, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43,
+	0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12,
+	0x22, 0x0a, 0x1e, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x43, 0x49, 0x4c, 0x49,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4d, 0x49, 0x53,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x32, 0xba, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x6b, 0x50,
+	0x75, 0x73, 0x68, 0x56, 0x31, 0x12, 0x78, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x53, 0x54, 0x4b, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53,
+	0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31,
+	0x3a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x3a, 0x01, 0x2a, 0x12,
+	0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x74, 0x6b, 0x2f,
+	0x76, 0x31, 0x2f, 0x7b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x7e, 0x0a, 0x0d, 0x57, 0x61, 0x69, 0x74, 0x53, 0x74, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x53, 0x74, 0x6b, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x77,
+	0x61, 0x69, 0x74, 0x12, 0x8c, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x12, 0x0d, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x30, 0x01, 0x12, 0xa8, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x30, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
+	0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x73,
+	0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x81, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76,
+	0x31, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x74, 0x6b,
+	0x2f, 0x76, 0x31, 0x3a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a,
+	0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x3a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x2f, 0x7b, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x73, 0x74, 0x6b,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x89, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x73, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x73,
+	0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x3a,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x9c, 0x01, 0x0a, 0x13, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x2b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b,
+	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x22, 0x2a, 0x2f, 0x73, 0x74, 0x6b, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x9b, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x74, 0x6b, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x2c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
+	0x6b, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22,
+	0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x2f, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x7d, 0x3a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10,
+	0x12, 0x0e, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x78,
+	0x12, 0x81, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
+	0x13, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x8d, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x3a, 0x01, 0x2a, 0x12, 0x8d, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x70, 0x75, 0x72, 0x67,
+	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x64, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x73, 0x74,
+	0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x77, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6b, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
+	0x6b, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x72, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1a, 0x12, 0x18, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x2f, 0x7b, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x26, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x11, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x01, 0x2a, 0x12, 0xa6, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
+	0x6b, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
+	0x74, 0x6b, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12,
+	0x2b, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc5, 0x01, 0x0a,
+	0x1a, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x32, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
+	0x6b, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69,
+	0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x22, 0x33, 0x2f, 0x73,
+	0x74, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x69,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x3a, 0x01, 0x2a, 0x42, 0xe7, 0x03, 0x5a, 0x38, 0x62, 0x69, 0x74, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x6b, 0x61, 0x6d,
+	0x61, 0x75, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x6b, 0x5f, 0x76,
+	0x31, 0x92, 0x41, 0xa9, 0x03, 0x12, 0x97, 0x02, 0x0a, 0x11, 0x53, 0x54, 0x4b, 0x20, 0x4d, 0x70,
+	0x65, 0x73, 0x61, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x41, 0x50, 0x49,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20,
+	0x53, 0x54, 0x4b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x73, 0x0a, 0x15, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x20, 0x3c, 0x47, 0x69, 0x64, 0x65, 0x6f,
+	0x6e, 0x20, 0x4b, 0x61, 0x6d, 0x61, 0x75, 0x3e, 0x12, 0x43, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a,
+	0x2f, 0x2f, 0x62, 0x69, 0x74, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f,
+	0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x6b, 0x61, 0x6d, 0x61, 0x75, 0x2f, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x73, 0x74, 0x6b, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x74, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x67,
+	0x6b, 0x61, 0x6d, 0x61, 0x75, 0x40, 0x6f, 0x6e, 0x66, 0x6f, 0x6e, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x55, 0x0a, 0x1a, 0x47, 0x4e, 0x55, 0x20, 0x47, 0x45, 0x4e, 0x45,
+	0x52, 0x41, 0x4c, 0x20, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e,
+	0x53, 0x45, 0x12, 0x37, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x62, 0x69, 0x74, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e,
+	0x6b, 0x61, 0x6d, 0x61, 0x75, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2f, 0x6d,
+	0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x02, 0x76, 0x32, 0x2a,
+	0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x59, 0x0a, 0x57, 0x0a, 0x06, 0x62, 0x65, 0x61,
+	0x72, 0x65, 0x72, 0x12, 0x4d, 0x08, 0x02, 0x12, 0x38, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65,
+	0x72, 0x3a, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x3e, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x02, 0x62, 0x0a, 0x0a, 0x08, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_stk_v1_proto_rawDescOnce sync.Once
+	file_stk_v1_proto_rawDescData = file_stk_v1_proto_rawDesc
+)
+
+func file_stk_v1_proto_rawDescGZIP() []byte {
+	file_stk_v1_proto_rawDescOnce.Do(func() {
+		file_stk_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_stk_v1_proto_rawDescData)
+	})
+	return file_stk_v1_proto_rawDescData
+}
+
+var file_stk_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_stk_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_stk_v1_proto_goTypes = []interface{}{
+	(StkStatus)(0),                             // 0: gidyon.mpesastk.StkStatus
+	(StkTransactionType)(0),                    // 1: gidyon.mpesastk.StkTransactionType
+	(StkEventSource)(0),                        // 2: gidyon.mpesastk.StkEventSource
+	(StkOrderField)(0),                         // 3: gidyon.mpesastk.StkOrderField
+	(StkProcessedState)(0),                     // 4: gidyon.mpesastk.StkProcessedState
+	(StkSucceededState)(0),                     // 5: gidyon.mpesastk.StkSucceededState
+	(StkSortOrder)(0),                          // 6: gidyon.mpesastk.StkSortOrder
+	(ListStkTransactionsView)(0),               // 7: gidyon.mpesastk.ListStkTransactionsView
+	(StkTransactionEventType)(0),               // 8: gidyon.mpesastk.StkTransactionEventType
+	(StkCallbackState)(0),                      // 9: gidyon.mpesastk.StkCallbackState
+	(WebhookDeliveryState)(0),                  // 10: gidyon.mpesastk.WebhookDeliveryState
+	(OutboxEntryState)(0),                      // 11: gidyon.mpesastk.OutboxEntryState
+	(DeadLetterKind)(0),                        // 12: gidyon.mpesastk.DeadLetterKind
+	(StkStatsInterval)(0),                      // 13: gidyon.mpesastk.StkStatsInterval
+	(ExportFormat)(0),                          // 14: gidyon.mpesastk.ExportFormat
+	(ExportJobState)(0),                        // 15: gidyon.mpesastk.ExportJobState
+	(ReconciliationCategory)(0),                // 16: gidyon.mpesastk.ReconciliationCategory
+	(*StkTransaction)(nil),                     // 17: gidyon.mpesastk.StkTransaction
+	(*PublishInfo)(nil),                        // 18: gidyon.mpesastk.PublishInfo
+	(*TriggerSTKRequest)(nil),                  // 19: gidyon.mpesastk.TriggerSTKRequest
+	(*TriggerSTKResponse)(nil),                 // 20: gidyon.mpesastk.TriggerSTKResponse
+	(*InitiateSTKRequest)(nil),                 // 21: gidyon.mpesastk.InitiateSTKRequest
+	(*InitiateSTKResponse)(nil),                // 22: gidyon.mpesastk.InitiateSTKResponse
+	(*GetStkTransactionRequest)(nil),           // 23: gidyon.mpesastk.GetStkTransactionRequest
+	(*WaitStkResultRequest)(nil),               // 24: gidyon.mpesastk.WaitStkResultRequest
+	(*StkTransactionEvent)(nil),                // 25: gidyon.mpesastk.StkTransactionEvent
+	(*ListStkTransactionEventsRequest)(nil),    // 26: gidyon.mpesastk.ListStkTransactionEventsRequest
+	(*ListStkTransactionEventsResponse)(nil),   // 27: gidyon.mpesastk.ListStkTransactionEventsResponse
+	(*CreateStkTransactionRequest)(nil),        // 28: gidyon.mpesastk.CreateStkTransactionRequest
+	(*ListStkTransactionFilter)(nil),           // 29: gidyon.mpesastk.ListStkTransactionFilter
+	(*ListStkTransactionsRequest)(nil),         // 30: gidyon.mpesastk.ListStkTransactionsRequest
+	(*ListStkTransactionsResponse)(nil),        // 31: gidyon.mpesastk.ListStkTransactionsResponse
+	(*WatchStkTransactionsRequest)(nil),        // 32: gidyon.mpesastk.WatchStkTransactionsRequest
+	(*WatchStkTransactionsResponse)(nil),       // 33: gidyon.mpesastk.WatchStkTransactionsResponse
+	(*ProcessStkTransactionRequest)(nil),       // 34: gidyon.mpesastk.ProcessStkTransactionRequest
+	(*PublishStkTransactionRequest)(nil),       // 35: gidyon.mpesastk.PublishStkTransactionRequest
+	(*PublishMessage)(nil),                     // 36: gidyon.mpesastk.PublishMessage
+	(*CloudEvent)(nil),                         // 37: gidyon.mpesastk.CloudEvent
+	(*CloudEventAttributeValue)(nil),           // 38: gidyon.mpesastk.CloudEventAttributeValue
+	(*StkCallback)(nil),                        // 39: gidyon.mpesastk.StkCallback
+	(*GetStkCallbackRequest)(nil),              // 40: gidyon.mpesastk.GetStkCallbackRequest
+	(*ListStkCallbacksFilter)(nil),             // 41: gidyon.mpesastk.ListStkCallbacksFilter
+	(*ListStkCallbacksRequest)(nil),            // 42: gidyon.mpesastk.ListStkCallbacksRequest
+	(*ListStkCallbacksResponse)(nil),           // 43: gidyon.mpesastk.ListStkCallbacksResponse
+	(*ReplayCallbacksRequest)(nil),             // 44: gidyon.mpesastk.ReplayCallbacksRequest
+	(*StkFieldChange)(nil),                     // 45: gidyon.mpesastk.StkFieldChange
+	(*StkCallbackReplay)(nil),                  // 46: gidyon.mpesastk.StkCallbackReplay
+	(*ReplayCallbacksResponse)(nil),            // 47: gidyon.mpesastk.ReplayCallbacksResponse
+	(*SetInitiatorWebhookRequest)(nil),         // 48: gidyon.mpesastk.SetInitiatorWebhookRequest
+	(*InitiatorWebhook)(nil),                   // 49: gidyon.mpesastk.InitiatorWebhook
+	(*WebhookAttempt)(nil),                     // 50: gidyon.mpesastk.WebhookAttempt
+	(*WebhookDelivery)(nil),                    // 51: gidyon.mpesastk.WebhookDelivery
+	(*ListWebhookDeliveriesFilter)(nil),        // 52: gidyon.mpesastk.ListWebhookDeliveriesFilter
+	(*ListWebhookDeliveriesRequest)(nil),       // 53: gidyon.mpesastk.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),      // 54: gidyon.mpesastk.ListWebhookDeliveriesResponse
+	(*RetryWebhookDeliveryRequest)(nil),        // 55: gidyon.mpesastk.RetryWebhookDeliveryRequest
+	(*OutboxEntry)(nil),                        // 56: gidyon.mpesastk.OutboxEntry
+	(*ListOutboxEntriesRequest)(nil),           // 57: gidyon.mpesastk.ListOutboxEntriesRequest
+	(*ListOutboxEntriesResponse)(nil),          // 58: gidyon.mpesastk.ListOutboxEntriesResponse
+	(*DeadLetter)(nil),                         // 59: gidyon.mpesastk.DeadLetter
+	(*ListDeadLettersRequest)(nil),             // 60: gidyon.mpesastk.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),            // 61: gidyon.mpesastk.ListDeadLettersResponse
+	(*RetryDeadLettersRequest)(nil),            // 62: gidyon.mpesastk.RetryDeadLettersRequest
+	(*DeadLetterRetry)(nil),                    // 63: gidyon.mpesastk.DeadLetterRetry
+	(*RetryDeadLettersResponse)(nil),           // 64: gidyon.mpesastk.RetryDeadLettersResponse
+	(*PurgeDeadLettersRequest)(nil),            // 65: gidyon.mpesastk.PurgeDeadLettersRequest
+	(*PurgeDeadLettersResponse)(nil),           // 66: gidyon.mpesastk.PurgeDeadLettersResponse
+	(*StkProcessRequest)(nil),                  // 67: gidyon.mpesastk.StkProcessRequest
+	(*StkProcessAck)(nil),                      // 68: gidyon.mpesastk.StkProcessAck
+	(*GetStkStatsRequest)(nil),                 // 69: gidyon.mpesastk.GetStkStatsRequest
+	(*StkStatsGroup)(nil),                      // 70: gidyon.mpesastk.StkStatsGroup
+	(*StkStats)(nil),                           // 71: gidyon.mpesastk.StkStats
+	(*ExportStkTransactionsRequest)(nil),       // 72: gidyon.mpesastk.ExportStkTransactionsRequest
+	(*ExportStkTransactionsChunk)(nil),         // 73: gidyon.mpesastk.ExportStkTransactionsChunk
+	(*ExportJob)(nil),                          // 74: gidyon.mpesastk.ExportJob
+	(*CreateExportJobRequest)(nil),             // 75: gidyon.mpesastk.CreateExportJobRequest
+	(*GetExportJobRequest)(nil),                // 76: gidyon.mpesastk.GetExportJobRequest
+	(*ListExportJobsRequest)(nil),              // 77: gidyon.mpesastk.ListExportJobsRequest
+	(*ListExportJobsResponse)(nil),             // 78: gidyon.mpesastk.ListExportJobsResponse
+	(*Reconciliation)(nil),                     // 79: gidyon.mpesastk.Reconciliation
+	(*ReconciliationItem)(nil),                 // 80: gidyon.mpesastk.ReconciliationItem
+	(*ReconciliationReport)(nil),               // 81: gidyon.mpesastk.ReconciliationReport
+	(*RunReconciliationRequest)(nil),           // 82: gidyon.mpesastk.RunReconciliationRequest
+	(*GetReconciliationReportRequest)(nil),     // 83: gidyon.mpesastk.GetReconciliationReportRequest
+	(*CorrectReconciliationItemsRequest)(nil),  // 84: gidyon.mpesastk.CorrectReconciliationItemsRequest
+	(*CorrectReconciliationItemsResponse)(nil), // 85: gidyon.mpesastk.CorrectReconciliationItemsResponse
+	nil,                         // 86: gidyon.mpesastk.StkTransaction.CallbackExtrasEntry
+	nil,                         // 87: gidyon.mpesastk.PublishInfo.PayloadEntry
+	nil,                         // 88: gidyon.mpesastk.CloudEvent.AttributesEntry
+	nil,                         // 89: gidyon.mpesastk.StkCallback.HeadersEntry
+	(*any1.Any)(nil),            // 90: google.protobuf.Any
+	(*timestamp.Timestamp)(nil), // 91: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),       // 92: google.protobuf.Empty
+}
+var file_stk_v1_proto_depIdxs = []int32{
+	0,   // 0: gidyon.mpesastk.StkTransaction.status:type_name -> gidyon.mpesastk.StkStatus
+	1,   // 1: gidyon.mpesastk.StkTransaction.transaction_type:type_name -> gidyon.mpesastk.StkTransactionType
+	86,  // 2: gidyon.mpesastk.StkTransaction.callback_extras:type_name -> gidyon.mpesastk.StkTransaction.CallbackExtrasEntry
+	87,  // 3: gidyon.mpesastk.PublishInfo.payload:type_name -> gidyon.mpesastk.PublishInfo.PayloadEntry
+	18,  // 4: gidyon.mpesastk.InitiateSTKRequest.publish_message:type_name -> gidyon.mpesastk.PublishInfo
+	1,   // 5: gidyon.mpesastk.InitiateSTKRequest.transaction_type:type_name -> gidyon.mpesastk.StkTransactionType
+	17,  // 6: gidyon.mpesastk.InitiateSTKResponse.stk_transaction:type_name -> gidyon.mpesastk.StkTransaction
+	0,   // 7: gidyon.mpesastk.StkTransactionEvent.from_status:type_name -> gidyon.mpesastk.StkStatus
+	0,   // 8: gidyon.mpesastk.StkTransactionEvent.to_status:type_name -> gidyon.mpesastk.StkStatus
+	2,   // 9: gidyon.mpesastk.StkTransactionEvent.source:type_name -> gidyon.mpesastk.StkEventSource
+	25,  // 10: gidyon.mpesastk.ListStkTransactionEventsResponse.events:type_name -> gidyon.mpesastk.StkTransactionEvent
+	17,  // 11: gidyon.mpesastk.CreateStkTransactionRequest.payload:type_name -> gidyon.mpesastk.StkTransaction
+	0,   // 12: gidyon.mpesastk.ListStkTransactionFilter.stk_statuses:type_name -> gidyon.mpesastk.StkStatus
+	4,   // 13: gidyon.mpesastk.ListStkTransactionFilter.process_state:type_name -> gidyon.mpesastk.StkProcessedState
+	3,   // 14: gidyon.mpesastk.ListStkTransactionFilter.order_field:type_name -> gidyon.mpesastk.StkOrderField
+	1,   // 15: gidyon.mpesastk.ListStkTransactionFilter.transaction_types:type_name -> gidyon.mpesastk.StkTransactionType
+	6,   // 16: gidyon.mpesastk.ListStkTransactionFilter.sort_order:type_name -> gidyon.mpesastk.StkSortOrder
+	5,   // 17: gidyon.mpesastk.ListStkTransactionFilter.succeeded_state:type_name -> gidyon.mpesastk.StkSucceededState
+	29,  // 18: gidyon.mpesastk.ListStkTransactionsRequest.filter:type_name -> gidyon.mpesastk.ListStkTransactionFilter
+	7,   // 19: gidyon.mpesastk.ListStkTransactionsRequest.view:type_name -> gidyon.mpesastk.ListStkTransactionsView
+	17,  // 20: gidyon.mpesastk.ListStkTransactionsResponse.stk_transactions:type_name -> gidyon.mpesastk.StkTransaction
+	29,  // 21: gidyon.mpesastk.WatchStkTransactionsRequest.filter:type_name -> gidyon.mpesastk.ListStkTransactionFilter
+	8,   // 22: gidyon.mpesastk.WatchStkTransactionsResponse.event_type:type_name -> gidyon.mpesastk.StkTransactionEventType
+	17,  // 23: gidyon.mpesastk.WatchStkTransactionsResponse.stk_transaction:type_name -> gidyon.mpesastk.StkTransaction
+	36,  // 24: gidyon.mpesastk.PublishStkTransactionRequest.publish_message:type_name -> gidyon.mpesastk.PublishMessage
+	4,   // 25: gidyon.mpesastk.PublishStkTransactionRequest.processed_state:type_name -> gidyon.mpesastk.StkProcessedState
+	18,  // 26: gidyon.mpesastk.PublishMessage.publish_info:type_name -> gidyon.mpesastk.PublishInfo
+	17,  // 27: gidyon.mpesastk.PublishMessage.transaction_info:type_name -> gidyon.mpesastk.StkTransaction
+	88,  // 28: gidyon.mpesastk.CloudEvent.attributes:type_name -> gidyon.mpesastk.CloudEvent.AttributesEntry
+	90,  // 29: gidyon.mpesastk.CloudEvent.proto_data:type_name -> google.protobuf.Any
+	91,  // 30: gidyon.mpesastk.CloudEventAttributeValue.ce_timestamp:type_name -> google.protobuf.Timestamp
+	89,  // 31: gidyon.mpesastk.StkCallback.headers:type_name -> gidyon.mpesastk.StkCallback.HeadersEntry
+	9,   // 32: gidyon.mpesastk.StkCallback.state:type_name -> gidyon.mpesastk.StkCallbackState
+	9,   // 33: gidyon.mpesastk.ListStkCallbacksFilter.states:type_name -> gidyon.mpesastk.StkCallbackState
+	41,  // 34: gidyon.mpesastk.ListStkCallbacksRequest.filter:type_name -> gidyon.mpesastk.ListStkCallbacksFilter
+	39,  // 35: gidyon.mpesastk.ListStkCallbacksResponse.callbacks:type_name -> gidyon.mpesastk.StkCallback
+	41,  // 36: gidyon.mpesastk.ReplayCallbacksRequest.filter:type_name -> gidyon.mpesastk.ListStkCallbacksFilter
+	0,   // 37: gidyon.mpesastk.StkCallbackReplay.from_status:type_name -> gidyon.mpesastk.StkStatus
+	0,   // 38: gidyon.mpesastk.StkCallbackReplay.to_status:type_name -> gidyon.mpesastk.StkStatus
+	45,  // 39: gidyon.mpesastk.StkCallbackReplay.changes:type_name -> gidyon.mpesastk.StkFieldChange
+	46,  // 40: gidyon.mpesastk.ReplayCallbacksResponse.replays:type_name -> gidyon.mpesastk.StkCallbackReplay
+	10,  // 41: gidyon.mpesastk.WebhookDelivery.state:type_name -> gidyon.mpesastk.WebhookDeliveryState
+	50,  // 42: gidyon.mpesastk.WebhookDelivery.attempt_history:type_name -> gidyon.mpesastk.WebhookAttempt
+	10,  // 43: gidyon.mpesastk.ListWebhookDeliveriesFilter.states:type_name -> gidyon.mpesastk.WebhookDeliveryState
+	52,  // 44: gidyon.mpesastk.ListWebhookDeliveriesRequest.filter:type_name -> gidyon.mpesastk.ListWebhookDeliveriesFilter
+	51,  // 45: gidyon.mpesastk.ListWebhookDeliveriesResponse.deliveries:type_name -> gidyon.mpesastk.WebhookDelivery
+	11,  // 46: gidyon.mpesastk.OutboxEntry.state:type_name -> gidyon.mpesastk.OutboxEntryState
+	11,  // 47: gidyon.mpesastk.ListOutboxEntriesRequest.states:type_name -> gidyon.mpesastk.OutboxEntryState
+	56,  // 48: gidyon.mpesastk.ListOutboxEntriesResponse.entries:type_name -> gidyon.mpesastk.OutboxEntry
+	12,  // 49: gidyon.mpesastk.DeadLetter.kind:type_name -> gidyon.mpesastk.DeadLetterKind
+	12,  // 50: gidyon.mpesastk.ListDeadLettersRequest.kinds:type_name -> gidyon.mpesastk.DeadLetterKind
+	59,  // 51: gidyon.mpesastk.ListDeadLettersResponse.dead_letters:type_name -> gidyon.mpesastk.DeadLetter
+	63,  // 52: gidyon.mpesastk.RetryDeadLettersResponse.retries:type_name -> gidyon.mpesastk.DeadLetterRetry
+	12,  // 53: gidyon.mpesastk.PurgeDeadLettersRequest.kinds:type_name -> gidyon.mpesastk.DeadLetterKind
+	29,  // 54: gidyon.mpesastk.GetStkStatsRequest.filter:type_name -> gidyon.mpesastk.ListStkTransactionFilter
+	13,  // 55: gidyon.mpesastk.GetStkStatsRequest.interval:type_name -> gidyon.mpesastk.StkStatsInterval
+	70,  // 56: gidyon.mpesastk.StkStats.by_status:type_name -> gidyon.mpesastk.StkStatsGroup
+	70,  // 57: gidyon.mpesastk.StkStats.by_short_code:type_name -> gidyon.mpesastk.StkStatsGroup
+	70,  // 58: gidyon.mpesastk.StkStats.by_initiator:type_name -> gidyon.mpesastk.StkStatsGroup
+	70,  // 59: gidyon.mpesastk.StkStats.by_time:type_name -> gidyon.mpesastk.StkStatsGroup
+	70,  // 60: gidyon.mpesastk.StkStats.by_result_code:type_name -> gidyon.mpesastk.StkStatsGroup
+	13,  // 61: gidyon.mpesastk.StkStats.interval:type_name -> gidyon.mpesastk.StkStatsInterval
+	29,  // 62: gidyon.mpesastk.ExportStkTransactionsRequest.filter:type_name -> gidyon.mpesastk.ListStkTransactionFilter
+	14,  // 63: gidyon.mpesastk.ExportStkTransactionsRequest.format:type_name -> gidyon.mpesastk.ExportFormat
+	15,  // 64: gidyon.mpesastk.ExportJob.state:type_name -> gidyon.mpesastk.ExportJobState
+	72,  // 65: gidyon.mpesastk.ExportJob.export:type_name -> gidyon.mpesastk.ExportStkTransactionsRequest
+	72,  // 66: gidyon.mpesastk.CreateExportJobRequest.export:type_name -> gidyon.mpesastk.ExportStkTransactionsRequest
+	15,  // 67: gidyon.mpesastk.ListExportJobsRequest.states:type_name -> gidyon.mpesastk.ExportJobState
+	74,  // 68: gidyon.mpesastk.ListExportJobsResponse.export_jobs:type_name -> gidyon.mpesastk.ExportJob
+	16,  // 69: gidyon.mpesastk.ReconciliationItem.category:type_name -> gidyon.mpesastk.ReconciliationCategory
+	79,  // 70: gidyon.mpesastk.ReconciliationReport.reconciliation:type_name -> gidyon.mpesastk.Reconciliation
+	80,  // 71: gidyon.mpesastk.ReconciliationReport.items:type_name -> gidyon.mpesastk.ReconciliationItem
+	16,  // 72: gidyon.mpesastk.GetReconciliationReportRequest.categories:type_name -> gidyon.mpesastk.ReconciliationCategory
+	38,  // 73: gidyon.mpesastk.CloudEvent.AttributesEntry.value:type_name -> gidyon.mpesastk.CloudEventAttributeValue
+	21,  // 74: gidyon.mpesastk.StkPushV1.InitiateSTK:input_type -> gidyon.mpesastk.InitiateSTKRequest
+	23,  // 75: gidyon.mpesastk.StkPushV1.GetStkTransaction:input_type -> gidyon.mpesastk.GetStkTransactionRequest
+	24,  // 76: gidyon.mpesastk.StkPushV1.WaitStkResult:input_type -> gidyon.mpesastk.WaitStkResultRequest
+	32,  // 77: gidyon.mpesastk.StkPushV1.WatchStkTransactions:input_type -> gidyon.mpesastk.WatchStkTransactionsRequest
+	26,  // 78: gidyon.mpesastk.StkPushV1.ListStkTransactionEvents:input_type -> gidyon.mpesastk.ListStkTransactionEventsRequest
+	30,  // 79: gidyon.mpesastk.StkPushV1.ListStkTransactions:input_type -> gidyon.mpesastk.ListStkTransactionsRequest
+	34,  // 80: gidyon.mpesastk.StkPushV1.ProcessStkTransaction:input_type -> gidyon.mpesastk.ProcessStkTransactionRequest
+	35,  // 81: gidyon.mpesastk.StkPushV1.PublishStkTransaction:input_type -> gidyon.mpesastk.PublishStkTransactionRequest
+	40,  // 82: gidyon.mpesastk.StkPushV1.GetStkCallback:input_type -> gidyon.mpesastk.GetStkCallbackRequest
+	42,  // 83: gidyon.mpesastk.StkPushV1.ListStkCallbacks:input_type -> gidyon.mpesastk.ListStkCallbacksRequest
+	44,  // 84: gidyon.mpesastk.StkPushV1.ReplayCallbacks:input_type -> gidyon.mpesastk.ReplayCallbacksRequest
+	48,  // 85: gidyon.mpesastk.StkPushV1.SetInitiatorWebhook:input_type -> gidyon.mpesastk.SetInitiatorWebhookRequest
+	53,  // 86: gidyon.mpesastk.StkPushV1.ListWebhookDeliveries:input_type -> gidyon.mpesastk.ListWebhookDeliveriesRequest
+	55,  // 87: gidyon.mpesastk.StkPushV1.RetryWebhookDelivery:input_type -> gidyon.mpesastk.RetryWebhookDeliveryRequest
+	57,  // 88: gidyon.mpesastk.StkPushV1.ListOutboxEntries:input_type -> gidyon.mpesastk.ListOutboxEntriesRequest
+	60,  // 89: gidyon.mpesastk.StkPushV1.ListDeadLetters:input_type -> gidyon.mpesastk.ListDeadLettersRequest
+	62,  // 90: gidyon.mpesastk.StkPushV1.RetryDeadLetters:input_type -> gidyon.mpesastk.RetryDeadLettersRequest
+	65,  // 91: gidyon.mpesastk.StkPushV1.PurgeDeadLetters:input_type -> gidyon.mpesastk.PurgeDeadLettersRequest
+	69,  // 92: gidyon.mpesastk.StkPushV1.GetStkStats:input_type -> gidyon.mpesastk.GetStkStatsRequest
+	72,  // 93: gidyon.mpesastk.StkPushV1.ExportStkTransactions:input_type -> gidyon.mpesastk.ExportStkTransactionsRequest
+	75,  // 94: gidyon.mpesastk.StkPushV1.CreateExportJob:input_type -> gidyon.mpesastk.CreateExportJobRequest
+	76,  // 95: gidyon.mpesastk.StkPushV1.GetExportJob:input_type -> gidyon.mpesastk.GetExportJobRequest
+	77,  // 96: gidyon.mpesastk.StkPushV1.ListExportJobs:input_type -> gidyon.mpesastk.ListExportJobsRequest
+	82,  // 97: gidyon.mpesastk.StkPushV1.RunReconciliation:input_type -> gidyon.mpesastk.RunReconciliationRequest
+	83,  // 98: gidyon.mpesastk.StkPushV1.GetReconciliationReport:input_type -> gidyon.mpesastk.GetReconciliationReportRequest
+	84,  // 99: gidyon.mpesastk.StkPushV1.CorrectReconciliationItems:input_type -> gidyon.mpesastk.CorrectReconciliationItemsRequest
+	22,  // 100: gidyon.mpesastk.StkPushV1.InitiateSTK:output_type -> gidyon.mpesastk.InitiateSTKResponse
+	17,  // 101: gidyon.mpesastk.StkPushV1.GetStkTransaction:output_type -> gidyon.mpesastk.StkTransaction
+	17,  // 102: gidyon.mpesastk.StkPushV1.WaitStkResult:output_type -> gidyon.mpesastk.StkTransaction
+	33,  // 103: gidyon.mpesastk.StkPushV1.WatchStkTransactions:output_type -> gidyon.mpesastk.WatchStkTransactionsResponse
+	27,  // 104: gidyon.mpesastk.StkPushV1.ListStkTransactionEvents:output_type -> gidyon.mpesastk.ListStkTransactionEventsResponse
+	31,  // 105: gidyon.mpesastk.StkPushV1.ListStkTransactions:output_type -> gidyon.mpesastk.ListStkTransactionsResponse
+	92,  // 106: gidyon.mpesastk.StkPushV1.ProcessStkTransaction:output_type -> google.protobuf.Empty
+	92,  // 107: gidyon.mpesastk.StkPushV1.PublishStkTransaction:output_type -> google.protobuf.Empty
+	39,  // 108: gidyon.mpesastk.StkPushV1.GetStkCallback:output_type -> gidyon.mpesastk.StkCallback
+	43,  // 109: gidyon.mpesastk.StkPushV1.ListStkCallbacks:output_type -> gidyon.mpesastk.ListStkCallbacksResponse
+	47,  // 110: gidyon.mpesastk.StkPushV1.ReplayCallbacks:output_type -> gidyon.mpesastk.ReplayCallbacksResponse
+	49,  // 111: gidyon.mpesastk.StkPushV1.SetInitiatorWebhook:output_type -> gidyon.mpesastk.InitiatorWebhook
+	54,  // 112: gidyon.mpesastk.StkPushV1.ListWebhookDeliveries:output_type -> gidyon.mpesastk.ListWebhookDeliveriesResponse
+	51,  // 113: gidyon.mpesastk.StkPushV1.RetryWebhookDelivery:output_type -> gidyon.mpesastk.WebhookDelivery
+	58,  // 114: gidyon.mpesastk.StkPushV1.ListOutboxEntries:output_type -> gidyon.mpesastk.ListOutboxEntriesResponse
+	61,  // 115: gidyon.mpesastk.StkPushV1.ListDeadLetters:output_type -> gidyon.mpesastk.ListDeadLettersResponse
+	64,  // 116: gidyon.mpesastk.StkPushV1.RetryDeadLetters:output_type -> gidyon.mpesastk.RetryDeadLettersResponse
+	66,  // 117: gidyon.mpesastk.StkPushV1.PurgeDeadLetters:output_type -> gidyon.mpesastk.PurgeDeadLettersResponse
+	71,  // 118: gidyon.mpesastk.StkPushV1.GetStkStats:output_type -> gidyon.mpesastk.StkStats
+	73,  // 119: gidyon.mpesastk.StkPushV1.ExportStkTransactions:output_type -> gidyon.mpesastk.ExportStkTransactionsChunk
+	74,  // 120: gidyon.mpesastk.StkPushV1.CreateExportJob:output_type -> gidyon.mpesastk.ExportJob
+	74,  // 121: gidyon.mpesastk.StkPushV1.GetExportJob:output_type -> gidyon.mpesastk.ExportJob
+	78,  // 122: gidyon.mpesastk.StkPushV1.ListExportJobs:output_type -> gidyon.mpesastk.ListExportJobsResponse
+	81,  // 123: gidyon.mpesastk.StkPushV1.RunReconciliation:output_type -> gidyon.mpesastk.ReconciliationReport
+	81,  // 124: gidyon.mpesastk.StkPushV1.GetReconciliationReport:output_type -> gidyon.mpesastk.ReconciliationReport
+	85,  // 125: gidyon.mpesastk.StkPushV1.CorrectReconciliationItems:output_type -> gidyon.mpesastk.CorrectReconciliationItemsResponse
+	100, // [100:126] is the sub-list for method output_type
+	74,  // [74:100] is the sub-list for method input_type
+	74,  // [74:74] is the sub-list for extension type_name
+	74,  // [74:74] is the sub-list for extension extendee
+	0,   // [0:74] is the sub-list for field type_name
+}
+
+func init() { file_stk_v1_proto_init() }
+func file_stk_v1_proto_init() {
+	if File_stk_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_stk_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerSTKRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerSTKResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiateSTKRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiateSTKResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStkTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitStkResultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkTransactionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkTransactionEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkTransactionEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStkTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkTransactionFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStkTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStkTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStkTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishStkTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudEventAttributeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStkCallbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkCallbacksFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkCallbacksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStkCallbacksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayCallbacksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkFieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkCallbackReplay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayCallbacksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInitiatorWebhookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiatorWebhook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryWebhookDeliveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutboxEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutboxEntriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOutboxEntriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetterRetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkProcessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkProcessAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStkStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PublishMessage.ProtoReflect.Descriptor instead.
-func (*PublishMessage) Descriptor() ([]byte, []int) {
-	return file_stk_v1_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *PublishMessage) GetTransactionId() uint64 {
-	if x != nil {
-		return x.TransactionId
-	}
-	return 0
-}
-
-func (x *PublishMessage) GetInitiatorId() string {
-	if x != nil {
-		return x.InitiatorId
-	}
-	return ""
-}
-
-func (x *PublishMessage) GetMpesaReceiptId() string {
-	if x != nil {
-		return x.MpesaReceiptId
-	}
-	return ""
-}
-
-func (x *PublishMessage) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
-}
-
-func (x *PublishMessage) GetPublishInfo() *PublishInfo {
-	if x != nil {
-		return x.PublishInfo
-	}
-	return nil
-}
-
-func (x *PublishMessage) GetTransactionInfo() *StkTransaction {
-	if x != nil {
-		return x.TransactionInfo
-	}
-	return nil
-}
-
-var File_stk_v1_proto protoreflect.FileDescriptor
-
-var file_stk_v1_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x73, 0x74, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68,
-	0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
-	0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x08, 0x0a,
-	0x0e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x72, 0x63, 0x68,
-	0x61, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x73, 0x74, 0x6b, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x74, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a,
-	0x0f, 0x73, 0x74, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6b, 0x5f, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x74, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x28, 0x0a,
-	0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
-	0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x30, 0x92, 0x41, 0x2d,
-	0x0a, 0x2b, 0x2a, 0x0e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x32, 0x19, 0x53, 0x74, 0x6b, 0x20, 0x50, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22, 0xd9, 0x01,
-	0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a,
-	0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x3a, 0x0a,
-	0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x02, 0x0a, 0x11, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x65, 0x73, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x75, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x44, 0x75, 0x72, 0x3a,
-	0x5e, 0x92, 0x41, 0x5b, 0x0a, 0x59, 0x2a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53,
-	0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77, 0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x6e,
-	0x64, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0xd2,
-	0x01, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01,
-	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0xa4, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54, 0x4b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30,
-	0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x10,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x12, 0x19, 0x0a, 0x08, 0x77,
-	0x61, 0x69, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x77,
-	0x61, 0x69, 0x74, 0x44, 0x75, 0x72, 0x3a, 0x5e, 0x92, 0x41, 0x5b, 0x0a, 0x59, 0x2a, 0x11, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x32, 0x24, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x68, 0x61, 0x74, 0x20, 0x77,
-	0x69, 0x6c, 0x6c, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x74, 0x6f, 0x20,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0xd2, 0x01, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x05, 0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x1f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a,
-	0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x73, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x45, 0x0a, 0x0f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x3a, 0x75, 0x92, 0x41, 0x72, 0x0a, 0x70, 0x2a, 0x12, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x3a,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x73, 0x20, 0x61, 0x20, 0x53, 0x54, 0x4b, 0x20,
-	0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x6f, 0x20,
-	0x74, 0x68, 0x65, 0x20, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x20, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0xd2, 0x01, 0x0c, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x39, 0x2a,
-	0x13, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x22, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20,
-	0x53, 0x54, 0x4b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x22, 0xc2, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
-	0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x3a, 0x55, 0x92, 0x41, 0x52, 0x0a, 0x50, 0x2a, 0x18, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x23, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x73, 0x74, 0x6b,
-	0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0x01, 0x0e, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xaf, 0x01,
-	0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b,
-	0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x50, 0x92,
-	0x41, 0x4d, 0x0a, 0x4b, 0x2a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6b, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x32, 0x22, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0xd2, 0x01, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
-	0x85, 0x05, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
-	0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x73, 0x12,
-	0x25, 0x0a, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x20, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x1e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74,
-	0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x74, 0x6b, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
-	0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x73, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74,
-	0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65,
-	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3f, 0x0a, 0x0b, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
-	0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x49, 0x92, 0x41,
-	0x46, 0x0a, 0x44, 0x2a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x32, 0x28, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x66, 0x6f,
-	0x72, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xb3, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76,
-	0x69, 0x65, 0x77, 0x3a, 0x58, 0x92, 0x41, 0x55, 0x0a, 0x53, 0x2a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x35, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
-	0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20,
-	0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x96, 0x02,
-	0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4a, 0x0a, 0x10, 0x73, 0x74, 0x6b, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74,
-	0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0f, 0x73, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x58, 0x92, 0x41,
-	0x55, 0x0a, 0x53, 0x2a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x32, 0x34, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x1c, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2a, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73,
-	0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x1c, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
-	0x73, 0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
-	0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x3a, 0x4a, 0x92, 0x41, 0x47, 0x0a, 0x45, 0x2a, 0x1c, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x25, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x61, 0x20,
-	0x73, 0x74, 0x6b, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x22, 0xef, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65,
-	0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73,
-	0x74, 0x6b, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4a, 0x0a, 0x10, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
-	0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a, 0x34, 0x2a, 0x0e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x22,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x20, 0x73, 0x74, 0x6b, 0x20, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
-	0x72, 0x73, 0x2a, 0xbe, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x4b, 0x5f,
-	0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x45, 0x44,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
-	0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54,
-	0x4b, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
-	0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x53,
-	0x54, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
-	0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x4b, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
-	0x44, 0x10, 0x07, 0x2a, 0x61, 0x0a, 0x0d, 0x53, 0x74, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x54, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f,
-	0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54,
-	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53,
-	0x54, 0x41, 0x4d, 0x50, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x11, 0x53, 0x74, 0x6b, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x53,
-	0x54, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11,
-	0x0a, 0x0d, 0x53, 0x54, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10,
-	0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x4f,
-	0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x4f, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x56,
-	0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x56, 0x49, 0x45,
-	0x57, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4f, 0x4e, 0x4c, 0x59,
-	0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x49, 0x4e, 0x49, 0x4d,
-	0x41, 0x4c, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x32, 0xa3, 0x05, 0x0a, 0x09, 0x53, 0x74,
-	0x6b, 0x50, 0x75, 0x73, 0x68, 0x56, 0x31, 0x12, 0x78, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x12, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
-	0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x69,
-	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x73, 0x74, 0x6b, 0x2f,
-	0x76, 0x31, 0x3a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x54, 0x4b, 0x3a, 0x01,
-	0x2a, 0x12, 0x81, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x73, 0x74, 0x6b, 0x2e, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x74,
-	0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x81, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09,
-	0x12, 0x07, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x73, 0x74, 0x6b, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x6b,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x22, 0x22, 0x1d, 0x2f, 0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
-	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b,
-	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f,
-	0x73, 0x74, 0x6b, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74,
-	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x42,
-	0xe9, 0x03, 0x5a, 0x38, 0x62, 0x69, 0x74, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x6b, 0x61, 0x6d, 0x61, 0x75, 0x2f, 0x6d, 0x70,
-	0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
-	0x74, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x6b, 0x5f, 0x76, 0x31, 0x92, 0x41, 0xab, 0x03,
-	0x12, 0x97, 0x02, 0x0a, 0x11, 0x53, 0x54, 0x4b, 0x20, 0x4d, 0x70, 0x65, 0x73, 0x61, 0x20, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x41, 0x50, 0x49, 0x20, 0x66, 0x6f, 0x72, 0x20,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x53, 0x54, 0x4b, 0x20, 0x70,
-	0x75, 0x73, 0x68, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e,
-	0x67, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x73, 0x0a, 0x15, 0x47, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x20, 0x3c, 0x47, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x20, 0x4b, 0x61, 0x6d,
-	0x61, 0x75, 0x3e, 0x12, 0x43, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x62, 0x69, 0x74,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f,
-	0x6e, 0x6b, 0x61, 0x6d, 0x61, 0x75, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2f,
-	0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
-	0x74, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x67, 0x6b, 0x61, 0x6d, 0x61, 0x75,
-	0x40, 0x6f, 0x6e, 0x66, 0x6f, 0x6e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x63, 0x6f, 0x6d, 0x2a,
-	0x55, 0x0a, 0x1a, 0x47, 0x4e, 0x55, 0x20, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x20, 0x50,
-	0x55, 0x42, 0x4c, 0x49, 0x43, 0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x12, 0x37, 0x68,
-	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x62, 0x69, 0x74, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x6b, 0x61, 0x6d, 0x61, 0x75,
-	0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x73, 0x74, 0x6b, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c,
-	0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x02, 0x76, 0x32, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x5a, 0x59, 0x0a, 0x57, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x4d,
-	0x08, 0x02, 0x12, 0x38, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x3a, 0x20, 0x42, 0x65,
-	0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x1a, 0x0d, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x0c, 0x0a,
-	0x0a, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_stk_v1_proto_rawDescOnce sync.Once
-	file_stk_v1_proto_rawDescData = file_stk_v1_proto_rawDesc
-)
-
-func file_stk_v1_proto_rawDescGZIP() []byte {
-	file_stk_v1_proto_rawDescOnce.Do(func() {
-		file_stk_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_stk_v1_proto_rawDescData)
-	})
-	return file_stk_v1_proto_rawDescData
-}
-
-var file_stk_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_stk_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_stk_v1_proto_goTypes = []interface{}{
-	(StkStatus)(0),                       // 0: gidyon.mpesastk.StkStatus
-	(StkOrderField)(0),                   // 1: gidyon.mpesastk.StkOrderField
-	(StkProcessedState)(0),               // 2: gidyon.mpesastk.StkProcessedState
-	(ListStkTransactionsView)(0),         // 3: gidyon.mpesastk.ListStkTransactionsView
-	(*StkTransaction)(nil),               // 4: gidyon.mpesastk.StkTransaction
-	(*PublishInfo)(nil),                  // 5: gidyon.mpesastk.PublishInfo
-	(*TriggerSTKRequest)(nil),            // 6: gidyon.mpesastk.TriggerSTKRequest
-	(*TriggerSTKResponse)(nil),           // 7: gidyon.mpesastk.TriggerSTKResponse
-	(*InitiateSTKRequest)(nil),           // 8: gidyon.mpesastk.InitiateSTKRequest
-	(*InitiateSTKResponse)(nil),          // 9: gidyon.mpesastk.InitiateSTKResponse
-	(*GetStkTransactionRequest)(nil),     // 10: gidyon.mpesastk.GetStkTransactionRequest
-	(*CreateStkTransactionRequest)(nil),  // 11: gidyon.mpesastk.CreateStkTransactionRequest
-	(*ListStkTransactionFilter)(nil),     // 12: gidyon.mpesastk.ListStkTransactionFilter
-	(*ListStkTransactionsRequest)(nil),   // 13: gidyon.mpesastk.ListStkTransactionsRequest
-	(*ListStkTransactionsResponse)(nil),  // 14: gidyon.mpesastk.ListStkTransactionsResponse
-	(*ProcessStkTransactionRequest)(nil), // 15: gidyon.mpesastk.ProcessStkTransactionRequest
-	(*PublishStkTransactionRequest)(nil), // 16: gidyon.mpesastk.PublishStkTransactionRequest
-	(*PublishMessage)(nil),               // 17: gidyon.mpesastk.PublishMessage
-	nil,                                  // 18: gidyon.mpesastk.PublishInfo.PayloadEntry
-	(*emptypb.Empty)(nil),                // 19: google.protobuf.Empty
-}
-var file_stk_v1_proto_depIdxs = []int32{
-	0,  // 0: gidyon.mpesastk.StkTransaction.status:type_name -> gidyon.mpesastk.StkStatus
-	18, // 1: gidyon.mpesastk.PublishInfo.payload:type_name -> gidyon.mpesastk.PublishInfo.PayloadEntry
-	5,  // 2: gidyon.mpesastk.InitiateSTKRequest.publish_message:type_name -> gidyon.mpesastk.PublishInfo
-	4,  // 3: gidyon.mpesastk.CreateStkTransactionRequest.payload:type_name -> gidyon.mpesastk.StkTransaction
-	0,  // 4: gidyon.mpesastk.ListStkTransactionFilter.stk_statuses:type_name -> gidyon.mpesastk.StkStatus
-	2,  // 5: gidyon.mpesastk.ListStkTransactionFilter.process_state:type_name -> gidyon.mpesastk.StkProcessedState
-	1,  // 6: gidyon.mpesastk.ListStkTransactionFilter.order_field:type_name -> gidyon.mpesastk.StkOrderField
-	12, // 7: gidyon.mpesastk.ListStkTransactionsRequest.filter:type_name -> gidyon.mpesastk.ListStkTransactionFilter
-	3,  // 8: gidyon.mpesastk.ListStkTransactionsRequest.view:type_name -> gidyon.mpesastk.ListStkTransactionsView
-	4,  // 9: gidyon.mpesastk.ListStkTransactionsResponse.stk_transactions:type_name -> gidyon.mpesastk.StkTransaction
-	17, // 10: gidyon.mpesastk.PublishStkTransactionRequest.publish_message:type_name -> gidyon.mpesastk.PublishMessage
-	2,  // 11: gidyon.mpesastk.PublishStkTransactionRequest.processed_state:type_name -> gidyon.mpesastk.StkProcessedState
-	5,  // 12: gidyon.mpesastk.PublishMessage.publish_info:type_name -> gidyon.mpesastk.PublishInfo
-	4,  // 13: gidyon.mpesastk.PublishMessage.transaction_info:type_name -> gidyon.mpesastk.StkTransaction
-	8,  // 14: gidyon.mpesastk.StkPushV1.InitiateSTK:input_type -> gidyon.mpesastk.InitiateSTKRequest
-	10, // 15: gidyon.mpesastk.StkPushV1.GetStkTransaction:input_type -> gidyon.mpesastk.GetStkTransactionRequest
-	13, // 16: gidyon.mpesastk.StkPushV1.ListStkTransactions:input_type -> gidyon.mpesastk.ListStkTransactionsRequest
-	15, // 17: gidyon.mpesastk.StkPushV1.ProcessStkTransaction:input_type -> gidyon.mpesastk.ProcessStkTransactionRequest
-	16, // 18: gidyon.mpesastk.StkPushV1.PublishStkTransaction:input_type -> gidyon.mpesastk.PublishStkTransactionRequest
-	9,  // 19: gidyon.mpesastk.StkPushV1.InitiateSTK:output_type -> gidyon.mpesastk.InitiateSTKResponse
-	4,  // 20: gidyon.mpesastk.StkPushV1.GetStkTransaction:output_type -> gidyon.mpesastk.StkTransaction
-	14, // 21: gidyon.mpesastk.StkPushV1.ListStkTransactions:output_type -> gidyon.mpesastk.ListStkTransactionsResponse
-	19, // 22: gidyon.mpesastk.StkPushV1.ProcessStkTransaction:output_type -> google.protobuf.Empty
-	19, // 23: gidyon.mpesastk.StkPushV1.PublishStkTransaction:output_type -> google.protobuf.Empty
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
-}
-
-func init() { file_stk_v1_proto_init() }
-func file_stk_v1_proto_init() {
-	if File_stk_v1_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_stk_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StkTransaction); i {
+		file_stk_v1_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkStatsGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1962,8 +9349,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishInfo); i {
+		file_stk_v1_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StkStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1974,8 +9361,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerSTKRequest); i {
+		file_stk_v1_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStkTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1986,8 +9373,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerSTKResponse); i {
+		file_stk_v1_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportStkTransactionsChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1998,8 +9385,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitiateSTKRequest); i {
+		file_stk_v1_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2010,8 +9397,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitiateSTKResponse); i {
+		file_stk_v1_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateExportJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2022,8 +9409,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStkTransactionRequest); i {
+		file_stk_v1_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExportJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2034,8 +9421,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateStkTransactionRequest); i {
+		file_stk_v1_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExportJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2046,8 +9433,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStkTransactionFilter); i {
+		file_stk_v1_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListExportJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2058,8 +9445,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStkTransactionsRequest); i {
+		file_stk_v1_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reconciliation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2070,8 +9457,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStkTransactionsResponse); i {
+		file_stk_v1_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconciliationItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2082,8 +9469,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessStkTransactionRequest); i {
+		file_stk_v1_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconciliationReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2094,8 +9481,8 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishStkTransactionRequest); i {
+		file_stk_v1_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunReconciliationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2106,8 +9493,32 @@ func file_stk_v1_proto_init() {
 				return nil
 			}
 		}
-		file_stk_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishMessage); i {
+		file_stk_v1_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReconciliationReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrectReconciliationItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stk_v1_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CorrectReconciliationItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2119,13 +9530,27 @@ func file_stk_v1_proto_init() {
 			}
 		}
 	}
+	file_stk_v1_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*CloudEvent_BinaryData)(nil),
+		(*CloudEvent_TextData)(nil),
+		(*CloudEvent_ProtoData)(nil),
+	}
+	file_stk_v1_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*CloudEventAttributeValue_CeBoolean)(nil),
+		(*CloudEventAttributeValue_CeInteger)(nil),
+		(*CloudEventAttributeValue_CeString)(nil),
+		(*CloudEventAttributeValue_CeBytes)(nil),
+		(*CloudEventAttributeValue_CeUri)(nil),
+		(*CloudEventAttributeValue_CeUriRef)(nil),
+		(*CloudEventAttributeValue_CeTimestamp)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stk_v1_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   15,
+			NumEnums:      17,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
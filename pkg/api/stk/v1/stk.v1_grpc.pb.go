@@ -1,4 +1,8 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.5.1-go
+// source: stk.v1.proto
 
 package stk_v1
 
@@ -12,6 +16,7 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
 // StkPushV1Client is the client API for StkPushV1 service.
@@ -22,12 +27,55 @@ type StkPushV1Client interface {
 	InitiateSTK(ctx context.Context, in *InitiateSTKRequest, opts ...grpc.CallOption) (*InitiateSTKResponse, error)
 	// Retrieves a single stk transaction.
 	GetStkTransaction(ctx context.Context, in *GetStkTransactionRequest, opts ...grpc.CallOption) (*StkTransaction, error)
+	// Waits until stk transaction reaches a final status or timeout elapses.
+	WaitStkResult(ctx context.Context, in *WaitStkResultRequest, opts ...grpc.CallOption) (*StkTransaction, error)
+	// Streams stk transactions as they are created or change status.
+	WatchStkTransactions(ctx context.Context, in *WatchStkTransactionsRequest, opts ...grpc.CallOption) (StkPushV1_WatchStkTransactionsClient, error)
+	// Retrieves status history of a stk transaction.
+	ListStkTransactionEvents(ctx context.Context, in *ListStkTransactionEventsRequest, opts ...grpc.CallOption) (*ListStkTransactionEventsResponse, error)
 	// Retrieves a collection of stk transactions.
 	ListStkTransactions(ctx context.Context, in *ListStkTransactionsRequest, opts ...grpc.CallOption) (*ListStkTransactionsResponse, error)
 	// Processes stk transaction updating its status.
 	ProcessStkTransaction(ctx context.Context, in *ProcessStkTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Publishes stk transaction to consumers.
 	PublishStkTransaction(ctx context.Context, in *PublishStkTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Retrieves a single stk callback received from daraja.
+	GetStkCallback(ctx context.Context, in *GetStkCallbackRequest, opts ...grpc.CallOption) (*StkCallback, error)
+	// Retrieves a collection of stk callbacks received from daraja.
+	ListStkCallbacks(ctx context.Context, in *ListStkCallbacksRequest, opts ...grpc.CallOption) (*ListStkCallbacksResponse, error)
+	// Re-runs stored stk callbacks through callback processing.
+	ReplayCallbacks(ctx context.Context, in *ReplayCallbacksRequest, opts ...grpc.CallOption) (*ReplayCallbacksResponse, error)
+	// Sets the default webhook of an initiator.
+	SetInitiatorWebhook(ctx context.Context, in *SetInitiatorWebhookRequest, opts ...grpc.CallOption) (*InitiatorWebhook, error)
+	// Retrieves a collection of webhook deliveries.
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Schedules a webhook delivery to be sent again.
+	RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// Retrieves a collection of outbox entries awaiting or done with publishing.
+	ListOutboxEntries(ctx context.Context, in *ListOutboxEntriesRequest, opts ...grpc.CallOption) (*ListOutboxEntriesResponse, error)
+	// Retrieves a collection of dead letters.
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	// Publishes or processes dead letters again, removing the ones that succeed.
+	RetryDeadLetters(ctx context.Context, in *RetryDeadLettersRequest, opts ...grpc.CallOption) (*RetryDeadLettersResponse, error)
+	// Removes dead letters.
+	PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersResponse, error)
+	// Retrieves aggregated statistics of stk transactions.
+	GetStkStats(ctx context.Context, in *GetStkStatsRequest, opts ...grpc.CallOption) (*StkStats, error)
+	// Streams stk transactions as a csv, ndjson or xlsx file. Over http the file is downloaded from
+	// /stk/export.
+	ExportStkTransactions(ctx context.Context, in *ExportStkTransactionsRequest, opts ...grpc.CallOption) (StkPushV1_ExportStkTransactionsClient, error)
+	// Starts exporting stk transactions to a file in the background.
+	CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error)
+	// Retrieves an export job and the download link of its file once complete.
+	GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error)
+	// Retrieves a collection of export jobs.
+	ListExportJobs(ctx context.Context, in *ListExportJobsRequest, opts ...grpc.CallOption) (*ListExportJobsResponse, error)
+	// Reconciles an M-Pesa organisation statement against stk transactions and saves the report.
+	RunReconciliation(ctx context.Context, in *RunReconciliationRequest, opts ...grpc.CallOption) (*ReconciliationReport, error)
+	// Retrieves a reconciliation and a page of its items.
+	GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*ReconciliationReport, error)
+	// Marks transactions paid in the statement as successful.
+	CorrectReconciliationItems(ctx context.Context, in *CorrectReconciliationItemsRequest, opts ...grpc.CallOption) (*CorrectReconciliationItemsResponse, error)
 }
 
 type stkPushV1Client struct {
@@ -56,6 +104,56 @@ func (c *stkPushV1Client) GetStkTransaction(ctx context.Context, in *GetStkTrans
 	return out, nil
 }
 
+func (c *stkPushV1Client) WaitStkResult(ctx context.Context, in *WaitStkResultRequest, opts ...grpc.CallOption) (*StkTransaction, error) {
+	out := new(StkTransaction)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/WaitStkResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) WatchStkTransactions(ctx context.Context, in *WatchStkTransactionsRequest, opts ...grpc.CallOption) (StkPushV1_WatchStkTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StkPushV1_ServiceDesc.Streams[0], "/gidyon.mpesastk.StkPushV1/WatchStkTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stkPushV1WatchStkTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StkPushV1_WatchStkTransactionsClient interface {
+	Recv() (*WatchStkTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type stkPushV1WatchStkTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *stkPushV1WatchStkTransactionsClient) Recv() (*WatchStkTransactionsResponse, error) {
+	m := new(WatchStkTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stkPushV1Client) ListStkTransactionEvents(ctx context.Context, in *ListStkTransactionEventsRequest, opts ...grpc.CallOption) (*ListStkTransactionEventsResponse, error) {
+	out := new(ListStkTransactionEventsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListStkTransactionEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stkPushV1Client) ListStkTransactions(ctx context.Context, in *ListStkTransactionsRequest, opts ...grpc.CallOption) (*ListStkTransactionsResponse, error) {
 	out := new(ListStkTransactionsResponse)
 	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListStkTransactions", in, out, opts...)
@@ -83,6 +181,191 @@ func (c *stkPushV1Client) PublishStkTransaction(ctx context.Context, in *Publish
 	return out, nil
 }
 
+func (c *stkPushV1Client) GetStkCallback(ctx context.Context, in *GetStkCallbackRequest, opts ...grpc.CallOption) (*StkCallback, error) {
+	out := new(StkCallback)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/GetStkCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ListStkCallbacks(ctx context.Context, in *ListStkCallbacksRequest, opts ...grpc.CallOption) (*ListStkCallbacksResponse, error) {
+	out := new(ListStkCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListStkCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ReplayCallbacks(ctx context.Context, in *ReplayCallbacksRequest, opts ...grpc.CallOption) (*ReplayCallbacksResponse, error) {
+	out := new(ReplayCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ReplayCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) SetInitiatorWebhook(ctx context.Context, in *SetInitiatorWebhookRequest, opts ...grpc.CallOption) (*InitiatorWebhook, error) {
+	out := new(InitiatorWebhook)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/SetInitiatorWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) RetryWebhookDelivery(ctx context.Context, in *RetryWebhookDeliveryRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/RetryWebhookDelivery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ListOutboxEntries(ctx context.Context, in *ListOutboxEntriesRequest, opts ...grpc.CallOption) (*ListOutboxEntriesResponse, error) {
+	out := new(ListOutboxEntriesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListOutboxEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) RetryDeadLetters(ctx context.Context, in *RetryDeadLettersRequest, opts ...grpc.CallOption) (*RetryDeadLettersResponse, error) {
+	out := new(RetryDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/RetryDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) PurgeDeadLetters(ctx context.Context, in *PurgeDeadLettersRequest, opts ...grpc.CallOption) (*PurgeDeadLettersResponse, error) {
+	out := new(PurgeDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/PurgeDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) GetStkStats(ctx context.Context, in *GetStkStatsRequest, opts ...grpc.CallOption) (*StkStats, error) {
+	out := new(StkStats)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/GetStkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ExportStkTransactions(ctx context.Context, in *ExportStkTransactionsRequest, opts ...grpc.CallOption) (StkPushV1_ExportStkTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &StkPushV1_ServiceDesc.Streams[1], "/gidyon.mpesastk.StkPushV1/ExportStkTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stkPushV1ExportStkTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StkPushV1_ExportStkTransactionsClient interface {
+	Recv() (*ExportStkTransactionsChunk, error)
+	grpc.ClientStream
+}
+
+type stkPushV1ExportStkTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *stkPushV1ExportStkTransactionsClient) Recv() (*ExportStkTransactionsChunk, error) {
+	m := new(ExportStkTransactionsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stkPushV1Client) CreateExportJob(ctx context.Context, in *CreateExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/CreateExportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) GetExportJob(ctx context.Context, in *GetExportJobRequest, opts ...grpc.CallOption) (*ExportJob, error) {
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/GetExportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) ListExportJobs(ctx context.Context, in *ListExportJobsRequest, opts ...grpc.CallOption) (*ListExportJobsResponse, error) {
+	out := new(ListExportJobsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/ListExportJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) RunReconciliation(ctx context.Context, in *RunReconciliationRequest, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/RunReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) GetReconciliationReport(ctx context.Context, in *GetReconciliationReportRequest, opts ...grpc.CallOption) (*ReconciliationReport, error) {
+	out := new(ReconciliationReport)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/GetReconciliationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stkPushV1Client) CorrectReconciliationItems(ctx context.Context, in *CorrectReconciliationItemsRequest, opts ...grpc.CallOption) (*CorrectReconciliationItemsResponse, error) {
+	out := new(CorrectReconciliationItemsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesastk.StkPushV1/CorrectReconciliationItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StkPushV1Server is the server API for StkPushV1 service.
 // All implementations must embed UnimplementedStkPushV1Server
 // for forward compatibility
@@ -91,12 +374,55 @@ type StkPushV1Server interface {
 	InitiateSTK(context.Context, *InitiateSTKRequest) (*InitiateSTKResponse, error)
 	// Retrieves a single stk transaction.
 	GetStkTransaction(context.Context, *GetStkTransactionRequest) (*StkTransaction, error)
+	// Waits until stk transaction reaches a final status or timeout elapses.
+	WaitStkResult(context.Context, *WaitStkResultRequest) (*StkTransaction, error)
+	// Streams stk transactions as they are created or change status.
+	WatchStkTransactions(*WatchStkTransactionsRequest, StkPushV1_WatchStkTransactionsServer) error
+	// Retrieves status history of a stk transaction.
+	ListStkTransactionEvents(context.Context, *ListStkTransactionEventsRequest) (*ListStkTransactionEventsResponse, error)
 	// Retrieves a collection of stk transactions.
 	ListStkTransactions(context.Context, *ListStkTransactionsRequest) (*ListStkTransactionsResponse, error)
 	// Processes stk transaction updating its status.
 	ProcessStkTransaction(context.Context, *ProcessStkTransactionRequest) (*emptypb.Empty, error)
 	// Publishes stk transaction to consumers.
 	PublishStkTransaction(context.Context, *PublishStkTransactionRequest) (*emptypb.Empty, error)
+	// Retrieves a single stk callback received from daraja.
+	GetStkCallback(context.Context, *GetStkCallbackRequest) (*StkCallback, error)
+	// Retrieves a collection of stk callbacks received from daraja.
+	ListStkCallbacks(context.Context, *ListStkCallbacksRequest) (*ListStkCallbacksResponse, error)
+	// Re-runs stored stk callbacks through callback processing.
+	ReplayCallbacks(context.Context, *ReplayCallbacksRequest) (*ReplayCallbacksResponse, error)
+	// Sets the default webhook of an initiator.
+	SetInitiatorWebhook(context.Context, *SetInitiatorWebhookRequest) (*InitiatorWebhook, error)
+	// Retrieves a collection of webhook deliveries.
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Schedules a webhook delivery to be sent again.
+	RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*WebhookDelivery, error)
+	// Retrieves a collection of outbox entries awaiting or done with publishing.
+	ListOutboxEntries(context.Context, *ListOutboxEntriesRequest) (*ListOutboxEntriesResponse, error)
+	// Retrieves a collection of dead letters.
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	// Publishes or processes dead letters again, removing the ones that succeed.
+	RetryDeadLetters(context.Context, *RetryDeadLettersRequest) (*RetryDeadLettersResponse, error)
+	// Removes dead letters.
+	PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error)
+	// Retrieves aggregated statistics of stk transactions.
+	GetStkStats(context.Context, *GetStkStatsRequest) (*StkStats, error)
+	// Streams stk transactions as a csv, ndjson or xlsx file. Over http the file is downloaded from
+	// /stk/export.
+	ExportStkTransactions(*ExportStkTransactionsRequest, StkPushV1_ExportStkTransactionsServer) error
+	// Starts exporting stk transactions to a file in the background.
+	CreateExportJob(context.Context, *CreateExportJobRequest) (*ExportJob, error)
+	// Retrieves an export job and the download link of its file once complete.
+	GetExportJob(context.Context, *GetExportJobRequest) (*ExportJob, error)
+	// Retrieves a collection of export jobs.
+	ListExportJobs(context.Context, *ListExportJobsRequest) (*ListExportJobsResponse, error)
+	// Reconciles an M-Pesa organisation statement against stk transactions and saves the report.
+	RunReconciliation(context.Context, *RunReconciliationRequest) (*ReconciliationReport, error)
+	// Retrieves a reconciliation and a page of its items.
+	GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*ReconciliationReport, error)
+	// Marks transactions paid in the statement as successful.
+	CorrectReconciliationItems(context.Context, *CorrectReconciliationItemsRequest) (*CorrectReconciliationItemsResponse, error)
 	mustEmbedUnimplementedStkPushV1Server()
 }
 
@@ -110,6 +436,15 @@ func (UnimplementedStkPushV1Server) InitiateSTK(context.Context, *InitiateSTKReq
 func (UnimplementedStkPushV1Server) GetStkTransaction(context.Context, *GetStkTransactionRequest) (*StkTransaction, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStkTransaction not implemented")
 }
+func (UnimplementedStkPushV1Server) WaitStkResult(context.Context, *WaitStkResultRequest) (*StkTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitStkResult not implemented")
+}
+func (UnimplementedStkPushV1Server) WatchStkTransactions(*WatchStkTransactionsRequest, StkPushV1_WatchStkTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStkTransactions not implemented")
+}
+func (UnimplementedStkPushV1Server) ListStkTransactionEvents(context.Context, *ListStkTransactionEventsRequest) (*ListStkTransactionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStkTransactionEvents not implemented")
+}
 func (UnimplementedStkPushV1Server) ListStkTransactions(context.Context, *ListStkTransactionsRequest) (*ListStkTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStkTransactions not implemented")
 }
@@ -119,6 +454,60 @@ func (UnimplementedStkPushV1Server) ProcessStkTransaction(context.Context, *Proc
 func (UnimplementedStkPushV1Server) PublishStkTransaction(context.Context, *PublishStkTransactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishStkTransaction not implemented")
 }
+func (UnimplementedStkPushV1Server) GetStkCallback(context.Context, *GetStkCallbackRequest) (*StkCallback, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStkCallback not implemented")
+}
+func (UnimplementedStkPushV1Server) ListStkCallbacks(context.Context, *ListStkCallbacksRequest) (*ListStkCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStkCallbacks not implemented")
+}
+func (UnimplementedStkPushV1Server) ReplayCallbacks(context.Context, *ReplayCallbacksRequest) (*ReplayCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayCallbacks not implemented")
+}
+func (UnimplementedStkPushV1Server) SetInitiatorWebhook(context.Context, *SetInitiatorWebhookRequest) (*InitiatorWebhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInitiatorWebhook not implemented")
+}
+func (UnimplementedStkPushV1Server) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedStkPushV1Server) RetryWebhookDelivery(context.Context, *RetryWebhookDeliveryRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWebhookDelivery not implemented")
+}
+func (UnimplementedStkPushV1Server) ListOutboxEntries(context.Context, *ListOutboxEntriesRequest) (*ListOutboxEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxEntries not implemented")
+}
+func (UnimplementedStkPushV1Server) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedStkPushV1Server) RetryDeadLetters(context.Context, *RetryDeadLettersRequest) (*RetryDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDeadLetters not implemented")
+}
+func (UnimplementedStkPushV1Server) PurgeDeadLetters(context.Context, *PurgeDeadLettersRequest) (*PurgeDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
+func (UnimplementedStkPushV1Server) GetStkStats(context.Context, *GetStkStatsRequest) (*StkStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStkStats not implemented")
+}
+func (UnimplementedStkPushV1Server) ExportStkTransactions(*ExportStkTransactionsRequest, StkPushV1_ExportStkTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStkTransactions not implemented")
+}
+func (UnimplementedStkPushV1Server) CreateExportJob(context.Context, *CreateExportJobRequest) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExportJob not implemented")
+}
+func (UnimplementedStkPushV1Server) GetExportJob(context.Context, *GetExportJobRequest) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportJob not implemented")
+}
+func (UnimplementedStkPushV1Server) ListExportJobs(context.Context, *ListExportJobsRequest) (*ListExportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportJobs not implemented")
+}
+func (UnimplementedStkPushV1Server) RunReconciliation(context.Context, *RunReconciliationRequest) (*ReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunReconciliation not implemented")
+}
+func (UnimplementedStkPushV1Server) GetReconciliationReport(context.Context, *GetReconciliationReportRequest) (*ReconciliationReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationReport not implemented")
+}
+func (UnimplementedStkPushV1Server) CorrectReconciliationItems(context.Context, *CorrectReconciliationItemsRequest) (*CorrectReconciliationItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CorrectReconciliationItems not implemented")
+}
 func (UnimplementedStkPushV1Server) mustEmbedUnimplementedStkPushV1Server() {}
 
 // UnsafeStkPushV1Server may be embedded to opt out of forward compatibility for this service.
@@ -129,7 +518,7 @@ type UnsafeStkPushV1Server interface {
 }
 
 func RegisterStkPushV1Server(s grpc.ServiceRegistrar, srv StkPushV1Server) {
-	s.RegisterService(&_StkPushV1_serviceDesc, srv)
+	s.RegisterService(&StkPushV1_ServiceDesc, srv)
 }
 
 func _StkPushV1_InitiateSTK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -168,6 +557,63 @@ func _StkPushV1_GetStkTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StkPushV1_WaitStkResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitStkResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).WaitStkResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/WaitStkResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).WaitStkResult(ctx, req.(*WaitStkResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_WatchStkTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStkTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StkPushV1Server).WatchStkTransactions(m, &stkPushV1WatchStkTransactionsServer{stream})
+}
+
+type StkPushV1_WatchStkTransactionsServer interface {
+	Send(*WatchStkTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type stkPushV1WatchStkTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *stkPushV1WatchStkTransactionsServer) Send(m *WatchStkTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StkPushV1_ListStkTransactionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStkTransactionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ListStkTransactionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ListStkTransactionEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ListStkTransactionEvents(ctx, req.(*ListStkTransactionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StkPushV1_ListStkTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListStkTransactionsRequest)
 	if err := dec(in); err != nil {
@@ -222,7 +668,337 @@ func _StkPushV1_PublishStkTransaction_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _StkPushV1_serviceDesc = grpc.ServiceDesc{
+func _StkPushV1_GetStkCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStkCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).GetStkCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/GetStkCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).GetStkCallback(ctx, req.(*GetStkCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ListStkCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStkCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ListStkCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ListStkCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ListStkCallbacks(ctx, req.(*ListStkCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ReplayCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ReplayCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ReplayCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ReplayCallbacks(ctx, req.(*ReplayCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_SetInitiatorWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInitiatorWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).SetInitiatorWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/SetInitiatorWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).SetInitiatorWebhook(ctx, req.(*SetInitiatorWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_RetryWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).RetryWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/RetryWebhookDelivery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).RetryWebhookDelivery(ctx, req.(*RetryWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ListOutboxEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ListOutboxEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ListOutboxEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ListOutboxEntries(ctx, req.(*ListOutboxEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_RetryDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).RetryDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/RetryDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).RetryDeadLetters(ctx, req.(*RetryDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/PurgeDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).PurgeDeadLetters(ctx, req.(*PurgeDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_GetStkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).GetStkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/GetStkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).GetStkStats(ctx, req.(*GetStkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ExportStkTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStkTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StkPushV1Server).ExportStkTransactions(m, &stkPushV1ExportStkTransactionsServer{stream})
+}
+
+type StkPushV1_ExportStkTransactionsServer interface {
+	Send(*ExportStkTransactionsChunk) error
+	grpc.ServerStream
+}
+
+type stkPushV1ExportStkTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *stkPushV1ExportStkTransactionsServer) Send(m *ExportStkTransactionsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _StkPushV1_CreateExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).CreateExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/CreateExportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).CreateExportJob(ctx, req.(*CreateExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_GetExportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).GetExportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/GetExportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).GetExportJob(ctx, req.(*GetExportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_ListExportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).ListExportJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/ListExportJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).ListExportJobs(ctx, req.(*ListExportJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_RunReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).RunReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/RunReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).RunReconciliation(ctx, req.(*RunReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_GetReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).GetReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/GetReconciliationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).GetReconciliationReport(ctx, req.(*GetReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StkPushV1_CorrectReconciliationItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectReconciliationItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StkPushV1Server).CorrectReconciliationItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesastk.StkPushV1/CorrectReconciliationItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StkPushV1Server).CorrectReconciliationItems(ctx, req.(*CorrectReconciliationItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StkPushV1_ServiceDesc is the grpc.ServiceDesc for StkPushV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StkPushV1_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.mpesastk.StkPushV1",
 	HandlerType: (*StkPushV1Server)(nil),
 	Methods: []grpc.MethodDesc{
@@ -234,6 +1010,14 @@ var _StkPushV1_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStkTransaction",
 			Handler:    _StkPushV1_GetStkTransaction_Handler,
 		},
+		{
+			MethodName: "WaitStkResult",
+			Handler:    _StkPushV1_WaitStkResult_Handler,
+		},
+		{
+			MethodName: "ListStkTransactionEvents",
+			Handler:    _StkPushV1_ListStkTransactionEvents_Handler,
+		},
 		{
 			MethodName: "ListStkTransactions",
 			Handler:    _StkPushV1_ListStkTransactions_Handler,
@@ -246,7 +1030,86 @@ var _StkPushV1_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PublishStkTransaction",
 			Handler:    _StkPushV1_PublishStkTransaction_Handler,
 		},
+		{
+			MethodName: "GetStkCallback",
+			Handler:    _StkPushV1_GetStkCallback_Handler,
+		},
+		{
+			MethodName: "ListStkCallbacks",
+			Handler:    _StkPushV1_ListStkCallbacks_Handler,
+		},
+		{
+			MethodName: "ReplayCallbacks",
+			Handler:    _StkPushV1_ReplayCallbacks_Handler,
+		},
+		{
+			MethodName: "SetInitiatorWebhook",
+			Handler:    _StkPushV1_SetInitiatorWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _StkPushV1_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RetryWebhookDelivery",
+			Handler:    _StkPushV1_RetryWebhookDelivery_Handler,
+		},
+		{
+			MethodName: "ListOutboxEntries",
+			Handler:    _StkPushV1_ListOutboxEntries_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _StkPushV1_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "RetryDeadLetters",
+			Handler:    _StkPushV1_RetryDeadLetters_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _StkPushV1_PurgeDeadLetters_Handler,
+		},
+		{
+			MethodName: "GetStkStats",
+			Handler:    _StkPushV1_GetStkStats_Handler,
+		},
+		{
+			MethodName: "CreateExportJob",
+			Handler:    _StkPushV1_CreateExportJob_Handler,
+		},
+		{
+			MethodName: "GetExportJob",
+			Handler:    _StkPushV1_GetExportJob_Handler,
+		},
+		{
+			MethodName: "ListExportJobs",
+			Handler:    _StkPushV1_ListExportJobs_Handler,
+		},
+		{
+			MethodName: "RunReconciliation",
+			Handler:    _StkPushV1_RunReconciliation_Handler,
+		},
+		{
+			MethodName: "GetReconciliationReport",
+			Handler:    _StkPushV1_GetReconciliationReport_Handler,
+		},
+		{
+			MethodName: "CorrectReconciliationItems",
+			Handler:    _StkPushV1_CorrectReconciliationItems_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStkTransactions",
+			Handler:       _StkPushV1_WatchStkTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportStkTransactions",
+			Handler:       _StkPushV1_ExportStkTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "stk.v1.proto",
 }
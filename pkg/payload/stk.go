@@ -1,7 +1,10 @@
 package payload
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -35,101 +38,119 @@ type Item struct {
 	Value interface{} `json:"Value,omitempty"`
 }
 
-// GetAmount returns the transaction amount
-func (c *CallbackMeta) GetAmount() float32 {
-	itemsLen := len(c.Item)
-	if itemsLen != 5 && itemsLen != 4 {
-		return 0
+// Names of callback metadata items sent by daraja
+const (
+	ItemAmount             = "Amount"
+	ItemMpesaReceiptNumber = "MpesaReceiptNumber"
+	ItemBalance            = "Balance"
+	ItemTransactionDate    = "TransactionDate"
+	ItemPhoneNumber        = "PhoneNumber"
+)
+
+var knownItems = map[string]struct{}{
+	ItemAmount:             {},
+	ItemMpesaReceiptNumber: {},
+	ItemBalance:            {},
+	ItemTransactionDate:    {},
+	ItemPhoneNumber:        {},
+}
+
+// Get returns value of the item with the given name
+func (c *CallbackMeta) Get(name string) (interface{}, bool) {
+	for _, item := range c.Item {
+		if item.Name == name {
+			return item.Value, item.Value != nil
+		}
+	}
+	return nil, false
+}
+
+// GetString returns value of the item as a string. Numbers are formatted without exponent.
+func (c *CallbackMeta) GetString(name string) string {
+	v, ok := c.Get(name)
+	if !ok {
+		return ""
 	}
 
-	v, ok := c.Item[0].Value.(float64)
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case json.Number:
+		return val.String()
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// GetFloat returns value of the item as a number
+func (c *CallbackMeta) GetFloat(name string) (float64, bool) {
+	v, ok := c.Get(name)
 	if !ok {
-		return 0
+		return 0, false
 	}
 
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case json.Number:
+		f, err := val.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// GetAmount returns the transaction amount
+func (c *CallbackMeta) GetAmount() float32 {
+	v, _ := c.GetFloat(ItemAmount)
 	return float32(v)
 }
 
 // MpesaReceiptNumber returns the receipt number
 func (c *CallbackMeta) MpesaReceiptNumber() string {
-	itemsLen := len(c.Item)
-	if itemsLen != 5 && itemsLen != 4 {
-		return ""
-	}
-
-	return fmt.Sprint(c.Item[1].Value)
+	return c.GetString(ItemMpesaReceiptNumber)
 }
 
 // Balance returns the transaction balance
 func (c *CallbackMeta) Balance() string {
-	itemsLen := len(c.Item)
-	if itemsLen != 5 && itemsLen != 4 {
-		return ""
-	}
-
-	return fmt.Sprint(c.Item[2].Value)
+	return c.GetString(ItemBalance)
 }
 
 // GetTransTime returns the transaction time
 func (c *CallbackMeta) GetTransTime() time.Time {
-	itemsLen := len(c.Item)
-	if itemsLen != 5 && itemsLen != 4 {
+	t, err := getTransactionTime(c.GetString(ItemTransactionDate))
+	if err != nil {
 		return time.Now().UTC()
 	}
-
-	var (
-		t   time.Time
-		err error
-	)
-
-	switch itemsLen {
-	case 4:
-		t, err = getTransactionTime(fmt.Sprint(c.Item[2].Value))
-		if err != nil {
-			t = time.Now().UTC()
-		}
-	case 5:
-		t, err = getTransactionTime(fmt.Sprint(c.Item[3].Value))
-		if err != nil {
-			t = time.Now().UTC()
-		}
-	}
-
 	return t
 }
 
 // PhoneNumber returns the phone number
 func (c *CallbackMeta) PhoneNumber() string {
-	itemsLen := len(c.Item)
-	if itemsLen != 5 && itemsLen != 4 {
-		return ""
-	}
-
-	var (
-		v  float64
-		ok bool
-	)
+	return c.GetString(ItemPhoneNumber)
+}
 
-	switch itemsLen {
-	case 4:
-		v, ok = c.Item[3].Value.(float64)
-		if !ok {
-			return fmt.Sprint(c.Item[3].Value)
-		}
-	case 5:
-		v, ok = c.Item[4].Value.(float64)
-		if !ok {
-			return fmt.Sprint(c.Item[4].Value)
+// UnknownItems returns items that have no typed accessor keyed by their names
+func (c *CallbackMeta) UnknownItems() map[string]interface{} {
+	items := make(map[string]interface{})
+	for _, item := range c.Item {
+		if _, ok := knownItems[item.Name]; ok || item.Name == "" {
+			continue
 		}
+		items[item.Name] = item.Value
 	}
-
-	return fmt.Sprintf("%.0f", v)
+	return items
 }
 
 func getTransactionTime(transactionTimeStr string) (time.Time, error) {
 	// 20200816204116
 	if len(transactionTimeStr) != 14 {
-		return time.Now(), nil
+		return time.Now().UTC(), nil
 	}
 
 	timeRFC3339Str := fmt.Sprintf(
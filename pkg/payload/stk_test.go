@@ -0,0 +1,117 @@
+package payload
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func readCallback(t *testing.T, name string) *STKPayload {
+	t.Helper()
+
+	bs, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", name, err)
+	}
+
+	callback := &STKPayload{}
+	err = json.Unmarshal(bs, callback)
+	if err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", name, err)
+	}
+
+	return callback
+}
+
+func TestCallbackMeta(t *testing.T) {
+	tests := []struct {
+		file        string
+		resultCode  int
+		receipt     string
+		amount      float32
+		balance     string
+		transTime   time.Time
+		phoneNumber string
+		unknown     map[string]interface{}
+	}{
+		{
+			file:        "success.json",
+			receipt:     "LK451H35OP",
+			amount:      1,
+			transTime:   time.Date(2017, 11, 4, 18, 49, 44, 0, time.UTC),
+			phoneNumber: "254727894083",
+			unknown:     map[string]interface{}{},
+		},
+		{
+			file:        "reordered.json",
+			receipt:     "NLJ7RT61SV",
+			amount:      1500.5,
+			balance:     "4,500.00",
+			transTime:   time.Date(2019, 12, 19, 10, 21, 15, 0, time.UTC),
+			phoneNumber: "254708374149",
+			unknown:     map[string]interface{}{},
+		},
+		{
+			file:        "extra_items.json",
+			receipt:     "SD66A1B2C3",
+			amount:      250,
+			transTime:   time.Date(2024, 4, 6, 14, 35, 41, 0, time.UTC),
+			phoneNumber: "254708374149",
+			unknown: map[string]interface{}{
+				"MerchantAccount": "ACME",
+				"ChargeAmount":    2.5,
+				"PromoCode":       nil,
+			},
+		},
+		{
+			file:    "missing_items.json",
+			receipt: "RAB1C2D3E4",
+			amount:  10,
+			unknown: map[string]interface{}{},
+		},
+		{
+			file:       "failed.json",
+			resultCode: 1032,
+			unknown:    map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			cb := readCallback(t, tt.file).Body.STKCallback
+			meta := &cb.CallbackMetadata
+
+			if cb.ResultCode != tt.resultCode {
+				t.Errorf("ResultCode = %d, want %d", cb.ResultCode, tt.resultCode)
+			}
+			if got := meta.MpesaReceiptNumber(); got != tt.receipt {
+				t.Errorf("MpesaReceiptNumber() = %q, want %q", got, tt.receipt)
+			}
+			if got := meta.GetAmount(); got != tt.amount {
+				t.Errorf("GetAmount() = %v, want %v", got, tt.amount)
+			}
+			if got := meta.Balance(); got != tt.balance {
+				t.Errorf("Balance() = %q, want %q", got, tt.balance)
+			}
+			if got := meta.PhoneNumber(); got != tt.phoneNumber {
+				t.Errorf("PhoneNumber() = %q, want %q", got, tt.phoneNumber)
+			}
+			if got := meta.UnknownItems(); !reflect.DeepEqual(got, tt.unknown) {
+				t.Errorf("UnknownItems() = %v, want %v", got, tt.unknown)
+			}
+
+			// Callbacks without transaction date get the time they are read
+			before := time.Now().UTC()
+			got := meta.GetTransTime()
+			switch {
+			case !tt.transTime.IsZero() && !got.Equal(tt.transTime):
+				t.Errorf("GetTransTime() = %v, want %v", got, tt.transTime)
+			case tt.transTime.IsZero() && got.Before(before.Add(-time.Second)):
+				t.Errorf("GetTransTime() = %v, want current time", got)
+			}
+		})
+	}
+}
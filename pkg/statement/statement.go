@@ -0,0 +1,296 @@
+// Package statement parses csv statements exported from the M-Pesa organisation portal.
+//
+// A statement starts with a preamble of account details such as the short code, followed by a header
+// row and one row per transaction. Columns are located by their header names, so their order may vary
+// between exports.
+package statement
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Entry is a transaction in the statement
+type Entry struct {
+	// Line is the line of the row in the file
+	Line           int
+	ReceiptNo      string
+	CompletionTime time.Time
+	InitiationTime time.Time
+	Details        string
+	Status         string
+	PaidIn         float64
+	Withdrawn      float64
+	Balance        float64
+	ReasonType     string
+	OtherPartyInfo string
+	// PhoneNumber and Name are read from other party info. Masked digits are kept as '*'.
+	PhoneNumber   string
+	Name          string
+	AccountNumber string
+}
+
+// Completed reports whether the statement lists the transaction as completed
+func (e *Entry) Completed() bool {
+	return e.Status == "" || strings.EqualFold(e.Status, "completed")
+}
+
+// Statement is a parsed organisation statement
+type Statement struct {
+	ShortCode string
+	Entries   []*Entry
+}
+
+// ErrNoHeader is returned when the statement has no row with transaction column names
+var ErrNoHeader = errors.New("statement: header row not found")
+
+// Column names after normalization
+const (
+	colReceiptNo      = "receipt no"
+	colCompletionTime = "completion time"
+	colInitiationTime = "initiation time"
+	colDetails        = "details"
+	colStatus         = "transaction status"
+	colPaidIn         = "paid in"
+	colWithdrawn      = "withdrawn"
+	colBalance        = "balance"
+	colReasonType     = "reason type"
+	colOtherPartyInfo = "other party info"
+	colAccountNumber  = "a c no"
+)
+
+var requiredColumns = []string{colReceiptNo, colCompletionTime, colPaidIn}
+
+// timeLayouts are formats of times used by the portal in different exports
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"02-01-2006 15:04:05",
+	"02/01/2006 15:04:05",
+	"2006/01/02 15:04:05",
+	"02.01.2006 15:04:05",
+	"2006-01-02 15:04",
+	"02-01-2006 15:04",
+	"02/01/2006 15:04",
+	"2006-01-02T15:04:05",
+}
+
+// Parse reads a statement from r. Times in the statement are read in loc.
+func Parse(r io.Reader, loc *time.Location) (*Statement, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.TrimLeadingSpace = true
+
+	var (
+		st      = &Statement{}
+		columns map[string]int
+	)
+
+	for {
+		record, err := cr.Read()
+		switch {
+		case err == nil:
+		case errors.Is(err, io.EOF):
+			if columns == nil {
+				return nil, ErrNoHeader
+			}
+			return st, nil
+		default:
+			return nil, fmt.Errorf("statement: %v", err)
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		// Excel adds a byte order mark to csv files it saves
+		if line == 1 && len(record) > 0 {
+			record[0] = strings.TrimPrefix(record[0], "\ufeff")
+		}
+
+		if isBlank(record) {
+			continue
+		}
+
+		// Preamble
+		if columns == nil {
+			if cols, ok := headerColumns(record); ok {
+				columns = cols
+				continue
+			}
+			if st.ShortCode == "" {
+				st.ShortCode = shortCode(record)
+			}
+			continue
+		}
+
+		entry, err := parseEntry(record, columns, loc)
+		if err != nil {
+			return nil, fmt.Errorf("statement: line %d: %v", line, err)
+		}
+		if entry == nil {
+			continue
+		}
+		entry.Line = line
+
+		st.Entries = append(st.Entries, entry)
+	}
+}
+
+func headerColumns(record []string) (map[string]int, bool) {
+	columns := make(map[string]int, len(record))
+	for i, name := range record {
+		name = normalize(name)
+		if _, ok := columns[name]; !ok && name != "" {
+			columns[name] = i
+		}
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, false
+		}
+	}
+	return columns, true
+}
+
+// shortCode reads the short code from a preamble row such as "Short Code:,174379"
+func shortCode(record []string) string {
+	for i, cell := range record {
+		key, val, _ := strings.Cut(cell, ":")
+		if !strings.Contains(normalize(key), "short code") {
+			continue
+		}
+		if val = strings.TrimSpace(val); val != "" {
+			return val
+		}
+		for _, next := range record[i+1:] {
+			if next = strings.TrimSpace(next); next != "" {
+				return next
+			}
+		}
+	}
+	return ""
+}
+
+func parseEntry(record []string, columns map[string]int, loc *time.Location) (*Entry, error) {
+	cell := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entry := &Entry{
+		ReceiptNo:      strings.ToUpper(cell(colReceiptNo)),
+		Details:        cell(colDetails),
+		Status:         cell(colStatus),
+		ReasonType:     cell(colReasonType),
+		OtherPartyInfo: cell(colOtherPartyInfo),
+		AccountNumber:  cell(colAccountNumber),
+	}
+
+	// Summary rows at the end of some exports have no receipt
+	if entry.ReceiptNo == "" {
+		return nil, nil
+	}
+
+	var err error
+
+	entry.CompletionTime, err = parseTime(cell(colCompletionTime), loc)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect completion time: %v", err)
+	}
+	if entry.CompletionTime.IsZero() {
+		return nil, errors.New("missing completion time")
+	}
+	entry.InitiationTime, err = parseTime(cell(colInitiationTime), loc)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect initiation time: %v", err)
+	}
+
+	entry.PaidIn, err = parseAmount(cell(colPaidIn))
+	if err != nil {
+		return nil, fmt.Errorf("incorrect paid in amount: %v", err)
+	}
+	entry.Withdrawn, err = parseAmount(cell(colWithdrawn))
+	if err != nil {
+		return nil, fmt.Errorf("incorrect withdrawn amount: %v", err)
+	}
+	entry.Balance, err = parseAmount(cell(colBalance))
+	if err != nil {
+		return nil, fmt.Errorf("incorrect balance: %v", err)
+	}
+
+	entry.PhoneNumber, entry.Name = otherParty(entry.OtherPartyInfo)
+
+	return entry, nil
+}
+
+func parseTime(s string, loc *time.Location) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range timeLayouts {
+		t, err := time.ParseInLocation(layout, s, loc)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown time format %q", s)
+}
+
+func parseAmount(s string) (float64, error) {
+	s = strings.NewReplacer(",", "", " ", "").Replace(s)
+	if s == "" {
+		return 0, nil
+	}
+	// Withdrawals may be shown as negative amounts
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", s)
+	}
+	if v < 0 {
+		v = -v
+	}
+	return v, nil
+}
+
+// otherParty splits other party info such as "254712***678 - JOHN DOE" into phone number and name
+func otherParty(info string) (phone, name string) {
+	left, right, found := strings.Cut(info, " - ")
+	if !found {
+		return "", strings.TrimSpace(info)
+	}
+	left = strings.TrimSpace(left)
+	for _, r := range left {
+		if !unicode.IsDigit(r) && r != '*' {
+			return "", strings.TrimSpace(info)
+		}
+	}
+	return left, strings.TrimSpace(right)
+}
+
+func normalize(s string) string {
+	s = strings.ToLower(s)
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+func isBlank(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
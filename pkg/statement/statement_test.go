@@ -0,0 +1,169 @@
+package statement
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	eat := time.FixedZone("EAT", 3*3600)
+
+	tests := []struct {
+		file      string
+		shortCode string
+		entries   []Entry
+		err       string
+	}{
+		{
+			file:      "standard.csv",
+			shortCode: "174379",
+			entries: []Entry{
+				{
+					Line:           6,
+					ReceiptNo:      "SD61A2B3C4",
+					CompletionTime: time.Date(2024, 4, 6, 14, 35, 41, 0, eat),
+					InitiationTime: time.Date(2024, 4, 6, 14, 35, 20, 0, eat),
+					Status:         "Completed",
+					PaidIn:         1500,
+					Balance:        11500,
+					PhoneNumber:    "254712***678",
+					Name:           "JOHN DOE",
+					AccountNumber:  "INV001",
+				},
+				{
+					Line:           7,
+					ReceiptNo:      "SD62D5E6F7",
+					CompletionTime: time.Date(2024, 4, 6, 15, 2, 10, 0, eat),
+					InitiationTime: time.Date(2024, 4, 6, 15, 2, 2, 0, eat),
+					Status:         "Completed",
+					Withdrawn:      25,
+					Balance:        11475,
+				},
+			},
+		},
+		{
+			file:      "reordered.csv",
+			shortCode: "600000",
+			entries: []Entry{
+				{
+					Line:           3,
+					ReceiptNo:      "SD63G7H8I9",
+					CompletionTime: time.Date(2024, 4, 6, 16, 10, 5, 0, eat),
+					Status:         "Completed",
+					PaidIn:         250,
+					PhoneNumber:    "254700000000",
+					Name:           "JANE ROE",
+				},
+				{
+					Line:           4,
+					ReceiptNo:      "SD64J1K2L3",
+					CompletionTime: time.Date(2024, 4, 6, 16, 12, 0, 0, eat),
+					Status:         "Failed",
+					PaidIn:         100,
+					Name:           "ACME SUPPLIES",
+				},
+			},
+		},
+		{
+			file: "bom.csv",
+			entries: []Entry{
+				{
+					Line:           2,
+					ReceiptNo:      "SD65M4N5O6",
+					CompletionTime: time.Date(2024, 4, 6, 17, 0, 0, 0, eat),
+					PaidIn:         10,
+				},
+			},
+		},
+		{
+			file: "invalid_time.csv",
+			err:  "statement: line 4: incorrect completion time",
+		},
+		{
+			file: "no_header.csv",
+			err:  ErrNoHeader.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			f, err := os.Open(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatalf("failed to open %s: %v", tt.file, err)
+			}
+			defer f.Close()
+
+			st, err := Parse(f, eat)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Fatalf("Parse() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if st.ShortCode != tt.shortCode {
+				t.Errorf("ShortCode = %q, want %q", st.ShortCode, tt.shortCode)
+			}
+			if len(st.Entries) != len(tt.entries) {
+				t.Fatalf("got %d entries, want %d", len(st.Entries), len(tt.entries))
+			}
+
+			for i, want := range tt.entries {
+				got := st.Entries[i]
+				switch {
+				case got.Line != want.Line:
+					t.Errorf("entry %d: Line = %d, want %d", i, got.Line, want.Line)
+				case got.ReceiptNo != want.ReceiptNo:
+					t.Errorf("entry %d: ReceiptNo = %q, want %q", i, got.ReceiptNo, want.ReceiptNo)
+				case !got.CompletionTime.Equal(want.CompletionTime):
+					t.Errorf("entry %d: CompletionTime = %v, want %v", i, got.CompletionTime, want.CompletionTime)
+				case !got.InitiationTime.Equal(want.InitiationTime):
+					t.Errorf("entry %d: InitiationTime = %v, want %v", i, got.InitiationTime, want.InitiationTime)
+				case got.Status != want.Status:
+					t.Errorf("entry %d: Status = %q, want %q", i, got.Status, want.Status)
+				case got.PaidIn != want.PaidIn || got.Withdrawn != want.Withdrawn || got.Balance != want.Balance:
+					t.Errorf("entry %d: amounts = %v/%v/%v, want %v/%v/%v", i,
+						got.PaidIn, got.Withdrawn, got.Balance, want.PaidIn, want.Withdrawn, want.Balance)
+				case got.PhoneNumber != want.PhoneNumber || got.Name != want.Name:
+					t.Errorf("entry %d: other party = %q %q, want %q %q", i, got.PhoneNumber, got.Name, want.PhoneNumber, want.Name)
+				case got.AccountNumber != want.AccountNumber:
+					t.Errorf("entry %d: AccountNumber = %q, want %q", i, got.AccountNumber, want.AccountNumber)
+				}
+			}
+		})
+	}
+}
+
+func TestParseNoHeader(t *testing.T) {
+	_, err := Parse(strings.NewReader("Short Code:,174379\n"), nil)
+	if !errors.Is(err, ErrNoHeader) {
+		t.Errorf("Parse() error = %v, want %v", err, ErrNoHeader)
+	}
+}
+
+func TestEntryCompleted(t *testing.T) {
+	tests := []struct {
+		status    string
+		completed bool
+	}{
+		{status: "", completed: true},
+		{status: "Completed", completed: true},
+		{status: "COMPLETED", completed: true},
+		{status: "Failed"},
+		{status: "Pending"},
+	}
+
+	for _, tt := range tests {
+		e := &Entry{Status: tt.status}
+		if got := e.Completed(); got != tt.completed {
+			t.Errorf("Entry{Status: %q}.Completed() = %v, want %v", tt.status, got, tt.completed)
+		}
+	}
+}
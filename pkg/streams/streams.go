@@ -0,0 +1,284 @@
+// Package streams publishes and consumes messages on redis streams.
+//
+// Unlike redis PUBLISH, messages written to a stream are kept until trimmed, so consumers that
+// are offline when a message is published receive it once they are back. Consumers read through
+// consumer groups and acknowledge messages once handled; unacknowledged messages are delivered
+// again after they have been idle for a while.
+package streams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// PayloadField is the stream entry field that holds the message payload
+const PayloadField = "payload"
+
+const (
+	defaultCount   = 10
+	defaultBlock   = 5 * time.Second
+	defaultMinIdle = time.Minute
+	retryInterval  = time.Second
+)
+
+// Publish appends the payload to the stream, trimming the stream to about maxLen entries when maxLen is positive.
+// It returns the id of the stream entry.
+func Publish(ctx context.Context, client redis.Cmdable, stream string, maxLen int64, payload interface{}) (string, error) {
+	args := &redis.XAddArgs{
+		Stream: stream,
+		Values: map[string]interface{}{PayloadField: payload},
+	}
+	if maxLen > 0 {
+		args.MaxLen = maxLen
+		args.Approx = true
+	}
+	return client.XAdd(ctx, args).Result()
+}
+
+// Message is a stream entry delivered to a consumer
+type Message struct {
+	ID      string
+	Stream  string
+	Payload []byte
+	Values  map[string]interface{}
+	// Deliveries is the number of times the message has been delivered, including this one
+	Deliveries int64
+}
+
+// Handler handles a message. The message is acknowledged when the handler returns nil, otherwise
+// it is delivered again once it has been idle for ConsumerOptions.MinIdle.
+type Handler func(ctx context.Context, msg *Message) error
+
+// ConsumerOptions contain parameters for creating a stream consumer
+type ConsumerOptions struct {
+	Client redis.Cmdable
+	Stream string
+	Group  string
+	// Consumer is the name of the consumer in the group; it should be stable across restarts
+	// so that messages pending at shutdown are picked up again
+	Consumer string
+	// Count is the maximum number of messages read at once
+	Count int64
+	// Block is how long a read waits for new messages
+	Block time.Duration
+	// MinIdle is how long a message stays unacknowledged before it is claimed and delivered again
+	MinIdle time.Duration
+	// ErrorHandler is called with errors that do not stop the consumer
+	ErrorHandler func(err error)
+}
+
+// Consumer reads messages from a stream as a member of a consumer group
+type Consumer struct {
+	opt *ConsumerOptions
+}
+
+// NewConsumer creates a stream consumer
+func NewConsumer(opt *ConsumerOptions) (*Consumer, error) {
+	switch {
+	case opt == nil:
+		return nil, errors.New("missing consumer options")
+	case opt.Client == nil:
+		return nil, errors.New("missing redis client")
+	case opt.Stream == "":
+		return nil, errors.New("missing stream")
+	case opt.Group == "":
+		return nil, errors.New("missing consumer group")
+	case opt.Consumer == "":
+		return nil, errors.New("missing consumer name")
+	}
+
+	o := *opt
+	if o.Count <= 0 {
+		o.Count = defaultCount
+	}
+	if o.Block <= 0 {
+		o.Block = defaultBlock
+	}
+	if o.MinIdle <= 0 {
+		o.MinIdle = defaultMinIdle
+	}
+	if o.ErrorHandler == nil {
+		o.ErrorHandler = func(error) {}
+	}
+
+	return &Consumer{opt: &o}, nil
+}
+
+// Run creates the consumer group if missing and handles messages until ctx is done
+func (c *Consumer) Run(ctx context.Context, handler Handler) error {
+	err := c.opt.Client.XGroupCreateMkStream(ctx, c.opt.Stream, c.opt.Group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group: %v", err)
+	}
+
+	// Messages delivered to this consumer before a restart come first
+	err = c.drainPending(ctx, handler)
+	if err != nil {
+		return err
+	}
+
+	lastClaim := time.Now()
+
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if time.Since(lastClaim) >= c.opt.MinIdle {
+			c.claimIdle(ctx, handler)
+			lastClaim = time.Now()
+		}
+
+		streams, err := c.opt.Client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.opt.Group,
+			Consumer: c.opt.Consumer,
+			Streams:  []string{c.opt.Stream, ">"},
+			Count:    c.opt.Count,
+			Block:    c.opt.Block,
+		}).Result()
+		switch {
+		case err == nil:
+		case errors.Is(err, redis.Nil):
+			continue
+		case ctx.Err() != nil:
+			return ctx.Err()
+		default:
+			c.opt.ErrorHandler(fmt.Errorf("failed to read stream %s: %v", c.opt.Stream, err))
+			sleep(ctx, retryInterval)
+			continue
+		}
+
+		for _, stream := range streams {
+			c.handle(ctx, handler, stream.Messages, false)
+		}
+	}
+}
+
+// drainPending delivers messages that were read by this consumer but never acknowledged
+func (c *Consumer) drainPending(ctx context.Context, handler Handler) error {
+	start := "0"
+
+	for {
+		streams, err := c.opt.Client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.opt.Group,
+			Consumer: c.opt.Consumer,
+			Streams:  []string{c.opt.Stream, start},
+			Count:    c.opt.Count,
+		}).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return fmt.Errorf("failed to read pending messages: %v", err)
+		}
+
+		if len(streams) == 0 || len(streams[0].Messages) == 0 {
+			return nil
+		}
+
+		msgs := streams[0].Messages
+		c.handle(ctx, handler, msgs, true)
+
+		// Failed messages stay pending; move past them and leave them to claimIdle
+		start = msgs[len(msgs)-1].ID
+	}
+}
+
+// claimIdle takes over messages that have not been acknowledged for MinIdle, including ones whose
+// consumer went away, and delivers them again
+func (c *Consumer) claimIdle(ctx context.Context, handler Handler) {
+	start := "0-0"
+
+	for {
+		msgs, next, err := c.opt.Client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   c.opt.Stream,
+			Group:    c.opt.Group,
+			Consumer: c.opt.Consumer,
+			MinIdle:  c.opt.MinIdle,
+			Start:    start,
+			Count:    c.opt.Count,
+		}).Result()
+		if err != nil {
+			c.opt.ErrorHandler(fmt.Errorf("failed to claim idle messages: %v", err))
+			return
+		}
+
+		c.handle(ctx, handler, msgs, true)
+
+		if next == "0-0" || next == "" || len(msgs) == 0 {
+			return
+		}
+		start = next
+	}
+}
+
+func (c *Consumer) handle(ctx context.Context, handler Handler, msgs []redis.XMessage, redelivered bool) {
+	if len(msgs) == 0 {
+		return
+	}
+
+	deliveries := map[string]int64{}
+	if redelivered {
+		deliveries = c.deliveries(ctx, msgs)
+	}
+
+	for _, xmsg := range msgs {
+		msg := &Message{
+			ID:         xmsg.ID,
+			Stream:     c.opt.Stream,
+			Values:     xmsg.Values,
+			Deliveries: 1,
+		}
+		if v, ok := xmsg.Values[PayloadField].(string); ok {
+			msg.Payload = []byte(v)
+		}
+		if n, ok := deliveries[xmsg.ID]; ok {
+			msg.Deliveries = n
+		}
+
+		err := handler(ctx, msg)
+		if err != nil {
+			c.opt.ErrorHandler(fmt.Errorf("failed to handle message %s: %v", xmsg.ID, err))
+			continue
+		}
+
+		err = c.opt.Client.XAck(ctx, c.opt.Stream, c.opt.Group, xmsg.ID).Err()
+		if err != nil {
+			c.opt.ErrorHandler(fmt.Errorf("failed to acknowledge message %s: %v", xmsg.ID, err))
+		}
+	}
+}
+
+// deliveries returns delivery counts of pending messages
+func (c *Consumer) deliveries(ctx context.Context, msgs []redis.XMessage) map[string]int64 {
+	res := make(map[string]int64, len(msgs))
+
+	pending, err := c.opt.Client.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.opt.Stream,
+		Group:  c.opt.Group,
+		Start:  msgs[0].ID,
+		End:    msgs[len(msgs)-1].ID,
+		Count:  int64(len(msgs)),
+	}).Result()
+	if err != nil {
+		c.opt.ErrorHandler(fmt.Errorf("failed to get delivery counts: %v", err))
+		return res
+	}
+
+	for _, p := range pending {
+		res[p.ID] = p.RetryCount
+	}
+
+	return res
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
@@ -0,0 +1,174 @@
+// Package xlsx writes single sheet xlsx workbooks row by row.
+//
+// Rows are written straight to the underlying writer as they come, so workbooks of any size are
+// produced in constant memory. Strings are stored inline rather than in a shared strings table.
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ContentType is the media type of xlsx files
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// MaxRows is the maximum number of rows in a sheet
+const MaxRows = 1048576
+
+// ErrTooManyRows is returned when a row is written to a full sheet
+var ErrTooManyRows = errors.New("xlsx: sheet is full")
+
+var staticParts = []struct {
+	name    string
+	content string
+}{
+	{
+		name: "[Content_Types].xml",
+		content: xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`,
+	},
+	{
+		name: "_rels/.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/_rels/workbook.xml.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`,
+	},
+}
+
+// Writer writes rows of a single sheet workbook
+type Writer struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+// NewWriter starts a workbook with a sheet named sheetName
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+
+	for _, part := range staticParts {
+		err := writePart(zw, part.name, part.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	name, err := escape(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writePart(zw, "xl/workbook.xml", xml.Header+
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" `+
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`+
+		`<sheets><sheet name="`+name+`" sheetId="1" r:id="rId1"/></sheets></workbook>`)
+	if err != nil {
+		return nil, err
+	}
+
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	x := &Writer{zw: zw, sheet: bufio.NewWriter(sw)}
+
+	_, err = x.sheet.WriteString(xml.Header +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	if err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+// WriteRow appends a row to the sheet. Numeric values are stored as numbers and other values as strings.
+func (x *Writer) WriteRow(values ...interface{}) error {
+	if x.rows >= MaxRows {
+		return ErrTooManyRows
+	}
+	x.rows++
+
+	_, err := x.sheet.WriteString(`<row r="` + strconv.Itoa(x.rows) + `">`)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range values {
+		var num string
+		switch n := v.(type) {
+		case int:
+			num = strconv.Itoa(n)
+		case int64:
+			num = strconv.FormatInt(n, 10)
+		case uint64:
+			num = strconv.FormatUint(n, 10)
+		case float64:
+			num = strconv.FormatFloat(n, 'f', -1, 64)
+		}
+
+		if num != "" {
+			_, err = x.sheet.WriteString(`<c><v>` + num + `</v></c>`)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		text, err := escape(fmt.Sprint(v))
+		if err != nil {
+			return err
+		}
+		_, err = x.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">` + text + `</t></is></c>`)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = x.sheet.WriteString(`</row>`)
+	return err
+}
+
+// Close finishes the workbook. It does not close the underlying writer.
+func (x *Writer) Close() error {
+	_, err := x.sheet.WriteString(`</sheetData></worksheet>`)
+	if err != nil {
+		return err
+	}
+	err = x.sheet.Flush()
+	if err != nil {
+		return err
+	}
+	return x.zw.Close()
+}
+
+func writePart(zw *zip.Writer, name, content string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, content)
+	return err
+}
+
+func escape(s string) (string, error) {
+	var b strings.Builder
+	err := xml.EscapeText(&b, []byte(s))
+	return b.String(), err
+}
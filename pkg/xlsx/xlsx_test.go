@@ -0,0 +1,176 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type sheetXML struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			T string `xml:"t,attr"`
+			V string `xml:"v"`
+			S string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type workbookXML struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+func readPart(t *testing.T, zr *zip.Reader, name string, v interface{}) {
+	t.Helper()
+
+	f, err := zr.Open(name)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", name, err)
+	}
+	defer f.Close()
+
+	err = xml.NewDecoder(f).Decode(v)
+	if err != nil {
+		t.Fatalf("failed to decode %s: %v", name, err)
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	x, err := NewWriter(buf, `Payments <&> "April"`)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	rows := [][]interface{}{
+		{"receipt", "amount", "phone"},
+		{"SD61A2B3C4", 1500.5, "254712345678"},
+		{"<script>&amp;", int64(-25), uint64(18446744073709551615)},
+		{"  padded  ", 0, nil},
+	}
+
+	for _, row := range rows {
+		err = x.WriteRow(row...)
+		if err != nil {
+			t.Fatalf("WriteRow() error = %v", err)
+		}
+	}
+
+	err = x.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to read workbook: %v", err)
+	}
+
+	for _, part := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels"} {
+		var v struct{}
+		readPart(t, zr, part, &v)
+	}
+
+	wb := &workbookXML{}
+	readPart(t, zr, "xl/workbook.xml", wb)
+	if len(wb.Sheets) != 1 || wb.Sheets[0].Name != `Payments <&> "April"` {
+		t.Errorf("sheets = %+v, want one sheet named %q", wb.Sheets, `Payments <&> "April"`)
+	}
+
+	sheet := &sheetXML{}
+	readPart(t, zr, "xl/worksheets/sheet1.xml", sheet)
+
+	want := [][]string{
+		{"s:receipt", "s:amount", "s:phone"},
+		{"s:SD61A2B3C4", "n:1500.5", "s:254712345678"},
+		{"s:<script>&amp;", "n:-25", "n:18446744073709551615"},
+		{"s:  padded  ", "n:0", "s:<nil>"},
+	}
+
+	got := make([][]string, 0, len(sheet.Rows))
+	for i, row := range sheet.Rows {
+		if row.R != i+1 {
+			t.Errorf("row %d has number %d", i+1, row.R)
+		}
+		cells := make([]string, 0, len(row.Cells))
+		for _, c := range row.Cells {
+			switch c.T {
+			case "inlineStr":
+				cells = append(cells, "s:"+c.S)
+			case "":
+				cells = append(cells, "n:"+c.V)
+			default:
+				t.Errorf("row %d has cell of unknown type %q", i+1, c.T)
+			}
+		}
+		got = append(got, cells)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %q, want %q", got, want)
+	}
+}
+
+func TestWriterMaxRows(t *testing.T) {
+	x, err := NewWriter(io.Discard, "Sheet1")
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	// Skip to the last row
+	x.rows = MaxRows - 1
+
+	err = x.WriteRow("last")
+	if err != nil {
+		t.Fatalf("WriteRow() of row %d error = %v", MaxRows, err)
+	}
+
+	err = x.WriteRow("overflow")
+	if !errors.Is(err, ErrTooManyRows) {
+		t.Errorf("WriteRow() of row %d error = %v, want %v", MaxRows+1, err, ErrTooManyRows)
+	}
+}
+
+type failingWriter struct {
+	err error
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestWriterWriteError(t *testing.T) {
+	w := &failingWriter{err: errors.New("disk full")}
+
+	x, err := NewWriter(w, "Sheet1")
+	if err != nil {
+		if !errors.Is(err, w.err) {
+			t.Fatalf("NewWriter() error = %v, want %v", err, w.err)
+		}
+		return
+	}
+
+	// Writes are buffered, so the error surfaces once buffers are flushed
+	value := strings.Repeat("x", 1024)
+	for i := 0; i < 10000; i++ {
+		err = x.WriteRow(value, i)
+		if err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = x.Close()
+	}
+	if !errors.Is(err, w.err) {
+		t.Errorf("error = %v, want %v", err, w.err)
+	}
+}